@@ -0,0 +1,42 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/guialveess/opencli/internal/ai"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/ui"
+)
+
+var (
+	aiModel    string
+	embedModel string
+)
+
+// newAIProvider cria o provider de IA da configuração, aplicando --model e
+// --embed-model quando informados.
+func newAIProvider(cfg *config.Config) (ai.Provider, error) {
+	if aiModel != "" {
+		cfg.AI.Model = aiModel
+	}
+	if embedModel != "" {
+		cfg.AI.EmbedModel = embedModel
+	}
+	return ai.NewProvider(cfg.AI)
+}
+
+func printAIHints(cfg config.AIConfig) {
+	fmt.Println()
+	switch cfg.Provider {
+	case ai.ProviderOpenAI:
+		ui.PrintInfo(fmt.Sprintf("Verifique se o servidor compatível com OpenAI está rodando em %s", cfg.BaseURL))
+		ui.PrintInfo("E se ele foi iniciado com um modelo de visão (ex: llama-server --mmproj ...)")
+	default:
+		model := cfg.Model
+		if model == "" {
+			model = "llava"
+		}
+		ui.PrintInfo("Verifique se o Ollama está rodando: ollama serve")
+		ui.PrintInfo(fmt.Sprintf("E se o modelo está instalado: ollama pull %s", model))
+	}
+}
@@ -0,0 +1,53 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/guialveess/opencli/internal/clipboard"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var copyFormat string
+
+// addCopyFlag registra --copy[=url|md|id] no comando.
+func addCopyFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&copyFormat, "copy", "", "Copia o link do Work Package: url, md (link markdown) ou id")
+	cmd.Flags().Lookup("copy").NoOptDefVal = "url"
+}
+
+func workPackageLink(client *openproject.Client, id int, subject, format string) (string, error) {
+	url := client.WorkPackageURL(id)
+
+	switch format {
+	case "url":
+		return url, nil
+	case "md", "markdown":
+		return fmt.Sprintf("[#%d %s](%s)", id, subject, url), nil
+	case "id":
+		return fmt.Sprintf("#%d", id), nil
+	default:
+		return "", fmt.Errorf("formato de cópia inválido: %s (use url, md ou id)", format)
+	}
+}
+
+// copyWorkPackageLink copia o link no formato escolhido em --copy, se informado.
+func copyWorkPackageLink(client *openproject.Client, id int, subject string) {
+	if copyFormat == "" {
+		return
+	}
+
+	text, err := workPackageLink(client, id, subject, copyFormat)
+	if err != nil {
+		ui.PrintError(err.Error())
+		return
+	}
+
+	if err := clipboard.WriteText(text); err != nil {
+		ui.PrintError(fmt.Sprintf("Erro ao copiar para o clipboard: %v", err))
+		return
+	}
+
+	ui.PrintSuccess(fmt.Sprintf("Copiado: %s", text))
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/dedup"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+)
+
+var skipDuplicates bool
+
+// duplicateAction é o que o usuário decidiu fazer após ver os possíveis duplicados.
+type duplicateAction struct {
+	CommentOn int
+	Subject   string // título do work package em CommentOn
+	Cancel    bool
+}
+
+// checkDuplicates procura work packages abertos parecidos com o que está para
+// ser criado e, se houver, pergunta se o usuário prefere comentar em um deles.
+// Com interactive=false apenas avisa e segue com a criação.
+// embedKey identifica o modelo de embeddings, invalidando o índice em cache quando muda.
+func checkDuplicates(client *openproject.Client, embedder dedup.Embedder, embedKey, subject, description string, interactive bool) duplicateAction {
+	ui.StartSpinner("Procurando Work Packages semelhantes...")
+	open, err := client.ListAllWorkPackages(openproject.OpenStatusFilter())
+	var result *dedup.Result
+	if err == nil {
+		finder := &dedup.Finder{
+			Embedder: embedder,
+			Model:    embedKey,
+			Project:  client.Project,
+			Limit:    3,
+		}
+		result = finder.Find(subject, description, open)
+	}
+	ui.StopSpinner()
+
+	if err != nil {
+		ui.PrintInfo(fmt.Sprintf("Não foi possível verificar duplicados: %v", err))
+		return duplicateAction{}
+	}
+
+	if len(result.Matches) == 0 {
+		return duplicateAction{}
+	}
+
+	warn := lipgloss.NewStyle().Bold(true).Foreground(warningColor)
+	scoreStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	fmt.Println()
+	fmt.Println(warn.Render("Possíveis duplicados encontrados:"))
+	for i, m := range result.Matches {
+		id := idStyle.Render(fmt.Sprintf("#%-5d", m.WorkPackage.ID))
+		status := statusStyle(m.WorkPackage.Links.Status.Title).Render(m.WorkPackage.Links.Status.Title)
+		score := scoreStyle.Render(fmt.Sprintf("%3.0f%%", m.Score*100))
+		fmt.Printf("  %d) %s %s  %s  %s\n", i+1, id, score, status, subjectStyle.Render(m.WorkPackage.Subject))
+	}
+	ui.PrintInfo(fmt.Sprintf("   (similaridade por %s)", result.Method))
+
+	if !interactive {
+		return duplicateAction{}
+	}
+
+	for {
+		response := ask("\nComentar em um existente? ", fmt.Sprintf("[1-%d / Enter cria novo / n cancela]: ", len(result.Matches)))
+		switch response {
+		case "":
+			return duplicateAction{}
+		case "n", "no", "nao", "não":
+			return duplicateAction{Cancel: true}
+		}
+
+		choice, err := strconv.Atoi(response)
+		if err == nil && choice >= 1 && choice <= len(result.Matches) {
+			wp := result.Matches[choice-1].WorkPackage
+			return duplicateAction{CommentOn: wp.ID, Subject: wp.Subject}
+		}
+		ui.PrintError("Opção inválida")
+	}
+}
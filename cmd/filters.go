@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/guialveess/opencli/internal/openproject"
+)
+
+// parseAssignments converte argumentos "campo=valor" (de --set e --filter) em
+// um mapa com os campos em minúsculas. Se um campo se repetir, vale o último.
+func parseAssignments(pairs []string) (map[string]string, error) {
+	fields := make(map[string]string, len(pairs))
+
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		key = strings.ToLower(strings.TrimSpace(key))
+		if !ok || key == "" {
+			return nil, fmt.Errorf("formato inválido: %q (use campo=valor)", pair)
+		}
+		fields[key] = strings.TrimSpace(value)
+	}
+
+	return fields, nil
+}
+
+// buildFilters converte pares campo=valor em filtros da API, resolvendo nomes
+// para IDs. status aceita open, closed e all além do nome; campos de usuário
+// aceitam "me" e "none". Sem filtro de status, apenas os abertos são incluídos,
+// como na listagem padrão do OpenProject.
+func buildFilters(client *openproject.Client, fields map[string]string) ([]openproject.Filter, error) {
+	var filters []openproject.Filter
+	hasStatus := false
+
+	for field, value := range fields {
+		switch field {
+		case "status":
+			hasStatus = true
+			switch strings.ToLower(value) {
+			case "open", "o":
+				filters = append(filters, openproject.OpenStatusFilter())
+			case "closed", "c":
+				filters = append(filters, openproject.Filter{Name: "status", Operator: "c"})
+			case "all", "*":
+				filters = append(filters, openproject.AnyStatusFilter())
+			default:
+				status, err := client.FindStatus(value)
+				if err != nil {
+					return nil, err
+				}
+				filters = append(filters, idFilter("status", status.ID))
+			}
+		case "type":
+			t, err := client.FindType(value)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, idFilter("type", t.ID))
+		case "priority":
+			p, err := client.FindPriority(value)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, idFilter("priority", p.ID))
+		case "version":
+			if strings.EqualFold(value, "none") {
+				filters = append(filters, openproject.Filter{Name: "version", Operator: "!*"})
+				continue
+			}
+			v, err := client.FindVersion(value)
+			if err != nil {
+				return nil, err
+			}
+			filters = append(filters, openproject.VersionFilter(v.ID))
+		case "assignee", "responsible", "author":
+			switch strings.ToLower(value) {
+			case "none":
+				filters = append(filters, openproject.Filter{Name: field, Operator: "!*"})
+			case "me":
+				filters = append(filters, openproject.Filter{Name: field, Operator: "=", Values: []string{"me"}})
+			default:
+				user, err := client.FindUser(value)
+				if err != nil {
+					return nil, err
+				}
+				filters = append(filters, idFilter(field, user.ID))
+			}
+		case "subject":
+			filters = append(filters, openproject.Filter{Name: "subject", Operator: "~", Values: []string{value}})
+		default:
+			return nil, fmt.Errorf("filtro desconhecido: %s (use status, type, priority, version, assignee, responsible, author ou subject)", field)
+		}
+	}
+
+	if !hasStatus {
+		filters = append(filters, openproject.OpenStatusFilter())
+	}
+
+	return filters, nil
+}
+
+func idFilter(name string, id int) openproject.Filter {
+	return openproject.Filter{Name: name, Operator: "=", Values: []string{strconv.Itoa(id)}}
+}
@@ -0,0 +1,355 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/notify"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+// minNotificationInterval evita consultar a central de notificações a cada
+// poucos segundos.
+const minNotificationInterval = 10 * time.Second
+
+var (
+	notificationsAll       bool
+	notificationsLimit     int
+	notificationsReadAll   bool
+	notificationsInterval  time.Duration
+	notificationsNoDesktop bool
+)
+
+var notificationsCmd = &cobra.Command{
+	Use:     "notifications",
+	Aliases: []string{"notif"},
+	Short:   "Central de notificações do OpenProject",
+	Long:    "Comandos para ler a central de notificações do OpenProject e receber notificações na área de trabalho.",
+}
+
+var notificationsListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lista as notificações não lidas",
+	Long:  "Lista as notificações não lidas, das mais recentes para as mais antigas. Use --all para incluir as lidas.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		_, client := notificationsClient()
+
+		ui.StartSpinner("Carregando notificações...")
+		notifications, err := client.ListNotifications(!notificationsAll, notificationsLimit)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar notificações: %v\n", err)
+			os.Exit(1)
+		}
+
+		header := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(primaryColor).
+			MarginBottom(1)
+
+		title := "Notificações não lidas"
+		if notificationsAll {
+			title = "Notificações"
+		}
+		fmt.Println(header.Render(fmt.Sprintf("%s (%d)", title, len(notifications))))
+
+		if len(notifications) == 0 {
+			ui.PrintInfo("Nenhuma notificação")
+			return
+		}
+
+		now := time.Now()
+		for _, n := range notifications {
+			fmt.Println(notificationRow(n, now))
+		}
+	},
+}
+
+var notificationsReadCmd = &cobra.Command{
+	Use:   "read [id...]",
+	Short: "Marca notificações como lidas",
+	Long: `Marca as notificações informadas como lidas, ou todas com --all.
+
+Exemplos:
+  op notifications read 812 813
+  op notifications read --all`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if notificationsReadAll == (len(args) > 0) {
+			fmt.Fprintln(os.Stderr, "Informe os IDs das notificações ou use --all")
+			os.Exit(1)
+		}
+
+		ids := make([]int, len(args))
+		for i, arg := range args {
+			id, err := strconv.Atoi(strings.TrimPrefix(arg, "#"))
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "ID inválido: %s\n", arg)
+				os.Exit(1)
+			}
+			ids[i] = id
+		}
+
+		_, client := notificationsClient()
+
+		if notificationsReadAll {
+			ui.StartSpinner("Marcando todas como lidas...")
+			err := client.MarkAllNotificationsRead()
+			ui.StopSpinner()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+				os.Exit(1)
+			}
+			ui.PrintSuccess("Todas as notificações foram marcadas como lidas")
+			return
+		}
+
+		failed := false
+		for _, id := range ids {
+			ui.StartSpinner(fmt.Sprintf("Marcando #%d como lida...", id))
+			err := client.MarkNotificationRead(id)
+			ui.StopSpinner()
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("#%d: %v", id, err))
+				failed = true
+				continue
+			}
+			ui.PrintSuccess(fmt.Sprintf("Notificação #%d marcada como lida", id))
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+var notificationsWatchCmd = &cobra.Command{
+	Use:   "watch",
+	Short: "Consulta periodicamente e exibe notificações na área de trabalho",
+	Long: `Fica consultando a central de notificações e, a cada notificação nova,
+exibe uma linha no terminal e uma notificação na área de trabalho (notify-send,
+D-Bus ou, no macOS, a central do sistema), até o Ctrl+C.
+
+As notificações que já estavam não lidas ao iniciar não são repetidas. Motivos,
+projetos, usuários e work packages em notifications.mute na configuração são
+ignorados, e acima de notifications.rate_limit por minuto as novas são
+agrupadas em um resumo.
+
+Exemplos:
+  op notifications watch
+  op notifications watch --interval 30s
+  op notifications watch --no-desktop`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, client := notificationsClient()
+
+		interval := cfg.Notifications.Interval
+		if cmd.Flags().Changed("interval") {
+			interval = notificationsInterval
+		}
+		if interval < minNotificationInterval {
+			fmt.Fprintf(os.Stderr, "Erro: o intervalo deve ser de pelo menos %s\n", minNotificationInterval)
+			os.Exit(1)
+		}
+
+		ui.StartSpinner("Carregando notificações...")
+		pending, err := client.ListNotifications(true, 100)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar notificações: %v\n", err)
+			os.Exit(1)
+		}
+
+		seen := make(map[int]bool, len(pending))
+		for _, n := range pending {
+			seen[n.ID] = true
+		}
+
+		muted := lipgloss.NewStyle().Foreground(mutedColor)
+		fmt.Println(muted.Render(fmt.Sprintf("%d notificação(ões) não lida(s). Verificando a cada %s; Ctrl+C para sair.", len(pending), interval)))
+
+		desktop := !notificationsNoDesktop
+		limiter := notify.NewLimiter(cfg.Notifications.RateLimit, time.Minute)
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(interval):
+			}
+
+			notifications, err := client.ListNotifications(true, 100)
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("%s falha ao consultar notificações: %v", time.Now().Format("15:04:05"), err))
+				continue
+			}
+
+			// a API retorna as mais recentes primeiro; notificamos na ordem em que aconteceram
+			slices.Reverse(notifications)
+
+			now := time.Now()
+			overflow := 0
+			for _, n := range notifications {
+				if seen[n.ID] {
+					continue
+				}
+				seen[n.ID] = true
+				if isMuted(n, cfg.Notifications.Mute) {
+					continue
+				}
+
+				fmt.Println(notificationRow(n, now))
+				if !desktop {
+					continue
+				}
+				if !limiter.Allow() {
+					overflow++
+					continue
+				}
+				if err := notify.Send(notificationTitle(n), notificationBody(n)); err != nil {
+					desktop = reportDesktopError(err)
+				}
+			}
+
+			// o resumo não passa pelo limite: no máximo um por consulta
+			if desktop && overflow > 0 {
+				if err := notify.Send("OpenProject", fmt.Sprintf("Mais %d notificação(ões) novas", overflow)); err != nil {
+					desktop = reportDesktopError(err)
+				}
+			}
+		}
+	},
+}
+
+// reportDesktopError avisa da falha e diz se vale continuar tentando: sem
+// sistema de notificações, desiste e segue só no terminal.
+func reportDesktopError(err error) bool {
+	if errors.Is(err, notify.ErrUnavailable) {
+		ui.PrintInfo(fmt.Sprintf("%v; exibindo só no terminal", err))
+		return false
+	}
+	ui.PrintError(fmt.Sprintf("Erro ao exibir notificação: %v", err))
+	return true
+}
+
+var notificationReasons = map[string]string{
+	"mentioned":   "menção",
+	"assigned":    "atribuído",
+	"responsible": "responsável",
+	"watched":     "observando",
+	"commented":   "comentário",
+	"created":     "criado",
+	"processed":   "processado",
+	"prioritized": "prioridade",
+	"scheduled":   "datas",
+	"dateAlert":   "prazo",
+}
+
+func reasonLabel(reason string) string {
+	if label, ok := notificationReasons[reason]; ok {
+		return label
+	}
+	return reason
+}
+
+func notificationRow(n openproject.Notification, now time.Time) string {
+	muted := lipgloss.NewStyle().Foreground(mutedColor)
+	reasonStyle := lipgloss.NewStyle().Foreground(infoColor).Width(12)
+	if n.Reason == "mentioned" {
+		reasonStyle = reasonStyle.Bold(true).Foreground(warningColor)
+	}
+
+	mark := " "
+	if !n.ReadIAN {
+		mark = lipgloss.NewStyle().Foreground(primaryColor).Render("●")
+	}
+
+	resource := n.Links.Resource.Title
+	if id := n.Links.Resource.ID(); id != 0 {
+		resource = idStyle.Render(fmt.Sprintf("#%d", id)) + " " + subjectStyle.Render(resource)
+	}
+
+	details := []string{}
+	if n.Links.Actor.Title != "" {
+		details = append(details, n.Links.Actor.Title)
+	}
+	if n.Links.Project.Title != "" {
+		details = append(details, n.Links.Project.Title)
+	}
+	details = append(details, timeAgo(n.CreatedAt, now))
+
+	return fmt.Sprintf("%s %s  %s  %s  %s", mark, muted.Render(fmt.Sprintf("%-6d", n.ID)), reasonStyle.Render(reasonLabel(n.Reason)), resource, muted.Render(strings.Join(details, " · ")))
+}
+
+func notificationTitle(n openproject.Notification) string {
+	title := "OpenProject: " + reasonLabel(n.Reason)
+	if n.Links.Actor.Title != "" {
+		title += " por " + n.Links.Actor.Title
+	}
+	return title
+}
+
+func notificationBody(n openproject.Notification) string {
+	if id := n.Links.Resource.ID(); id != 0 {
+		return fmt.Sprintf("#%d %s", id, n.Links.Resource.Title)
+	}
+	return n.Links.Resource.Title
+}
+
+// isMuted diz se a notificação está silenciada em notifications.mute.
+func isMuted(n openproject.Notification, mute config.NotificationMute) bool {
+	matches := func(list []string, link openproject.Link) bool {
+		for _, item := range list {
+			if strings.EqualFold(item, link.Title) || item == strconv.Itoa(link.ID()) {
+				return true
+			}
+		}
+		return false
+	}
+
+	for _, reason := range mute.Reasons {
+		if strings.EqualFold(reason, n.Reason) {
+			return true
+		}
+	}
+
+	return matches(mute.Projects, n.Links.Project) ||
+		matches(mute.Users, n.Links.Actor) ||
+		slices.Contains(mute.WorkPackages, n.Links.Resource.ID())
+}
+
+func notificationsClient() (*config.Config, *openproject.Client) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+		os.Exit(1)
+	}
+
+	return cfg, openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+}
+
+func init() {
+	notificationsListCmd.Flags().BoolVarP(&notificationsAll, "all", "a", false, "Inclui as notificações já lidas")
+	notificationsListCmd.Flags().IntVarP(&notificationsLimit, "limit", "n", 30, "Máximo de notificações exibidas")
+	notificationsReadCmd.Flags().BoolVar(&notificationsReadAll, "all", false, "Marca todas as notificações como lidas")
+	notificationsWatchCmd.Flags().DurationVar(&notificationsInterval, "interval", time.Minute, "Intervalo entre as consultas (padrão: notifications.interval ou 1m)")
+	notificationsWatchCmd.Flags().BoolVar(&notificationsNoDesktop, "no-desktop", false, "Exibe as notificações só no terminal")
+
+	notificationsCmd.AddCommand(notificationsListCmd)
+	notificationsCmd.AddCommand(notificationsReadCmd)
+	notificationsCmd.AddCommand(notificationsWatchCmd)
+	rootCmd.AddCommand(notificationsCmd)
+}
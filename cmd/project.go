@@ -0,0 +1,38 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/spf13/cobra"
+)
+
+var projectCmd = &cobra.Command{
+	Use:   "project",
+	Short: "Gerencia o projeto configurado",
+	Long:  "Comandos relacionados ao projeto configurado no OpenProject.",
+}
+
+var projectOpenCmd = &cobra.Command{
+	Use:   "open",
+	Short: "Abre o projeto no navegador",
+	Long:  "Abre a página do projeto configurado no navegador padrão.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+		openURL(client.ProjectURL())
+	},
+}
+
+func init() {
+	projectCmd.AddCommand(projectOpenCmd)
+	rootCmd.AddCommand(projectCmd)
+}
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+var stdinReader = bufio.NewReader(os.Stdin)
+
+var promptStyle = lipgloss.NewStyle().
+	Foreground(lipgloss.Color("#A78BFA")).
+	Bold(true)
+
+// ask exibe a pergunta e retorna a resposta digitada, em minúsculas e sem espaços.
+func ask(question, hint string) string {
+	fmt.Print(promptStyle.Render(question))
+	if hint != "" {
+		fmt.Print(hint)
+	}
+
+	response, _ := stdinReader.ReadString('\n')
+	return strings.TrimSpace(strings.ToLower(response))
+}
+
+func isYes(response string) bool {
+	return response == "" || response == "y" || response == "yes" || response == "s" || response == "sim"
+}
@@ -0,0 +1,279 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var queryCmd = &cobra.Command{
+	Use:   "query",
+	Short: "Usa as consultas salvas do OpenProject",
+	Long:  "Comandos para listar e executar as consultas salvas (visões e boards) do projeto configurado no OpenProject.",
+}
+
+var queryListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lista as consultas salvas e os presets locais",
+	Long:  "Lista as consultas salvas do projeto (e as globais) com seus filtros, e os presets de filtro definidos na configuração.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, client := queryClient()
+
+		ui.StartSpinner("Carregando consultas...")
+		queries, err := client.ListQueries()
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar consultas: %v\n", err)
+			os.Exit(1)
+		}
+
+		header := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(primaryColor).
+			MarginBottom(1)
+		muted := lipgloss.NewStyle().Foreground(mutedColor)
+		star := lipgloss.NewStyle().Foreground(warningColor)
+
+		// favoritas primeiro, como na barra lateral da interface web
+		sort.SliceStable(queries, func(i, j int) bool { return queries[i].Starred && !queries[j].Starred })
+
+		fmt.Println(header.Render(fmt.Sprintf("Consultas salvas (%d)", len(queries))))
+		if len(queries) == 0 {
+			ui.PrintInfo("Nenhuma consulta salva no projeto")
+		}
+		for _, q := range queries {
+			mark := " "
+			if q.Starred {
+				mark = star.Render("★")
+			}
+			visibility := "privada"
+			if q.Public {
+				visibility = "pública"
+			}
+			if q.Links.Project.Href == "" {
+				visibility += ", global"
+			}
+
+			fmt.Printf("%s %s  %s  %s\n", mark, idStyle.Render(fmt.Sprintf("#%-5d", q.ID)), subjectStyle.Render(q.Name), muted.Render("("+visibility+")"))
+			if len(q.Filters) > 0 {
+				filters := make([]string, len(q.Filters))
+				for i, f := range q.Filters {
+					filters[i] = f.String()
+				}
+				fmt.Println(muted.Render("          " + strings.Join(filters, " · ")))
+			}
+		}
+
+		if len(cfg.Presets) == 0 {
+			return
+		}
+
+		fmt.Println()
+		fmt.Println(header.Render(fmt.Sprintf("Presets locais (%d)", len(cfg.Presets))))
+		for _, name := range presetNames(cfg) {
+			preset := cfg.Presets[name]
+			pairs := make([]string, 0, len(preset))
+			for field, value := range preset {
+				pairs = append(pairs, field+"="+value)
+			}
+			sort.Strings(pairs)
+			fmt.Printf("  %s  %s\n", subjectStyle.Render(name), muted.Render(strings.Join(pairs, " · ")))
+		}
+		fmt.Println()
+		fmt.Println(muted.Render("Use op wp list --preset <nome> para aplicar um preset."))
+	},
+}
+
+var queryRunCmd = &cobra.Command{
+	Use:   "run <nome|id>",
+	Short: "Executa uma consulta salva",
+	Long: `Executa uma consulta salva no servidor, com os filtros, a ordenação e as
+colunas definidos nela.
+
+Exemplos:
+  op query run "Ready for QA"
+  op query run 42`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := strings.Join(args, " ")
+		_, client := queryClient()
+
+		ui.StartSpinner("Executando consulta...")
+		query, err := client.FindQuery(name)
+		var result *openproject.QueryResult
+		if err == nil {
+			result, err = client.RunQuery(query.ID)
+		}
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		header := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(primaryColor)
+		muted := lipgloss.NewStyle().Foreground(mutedColor)
+
+		fmt.Println(header.Render(fmt.Sprintf("%s (%d)", result.Query.Name, result.Total)))
+		if len(result.Query.Links.SortBy) > 0 {
+			sorts := make([]string, len(result.Query.Links.SortBy))
+			for i, s := range result.Query.Links.SortBy {
+				sorts[i] = s.Title
+			}
+			fmt.Println(muted.Render("Ordenado por " + strings.Join(sorts, ", ")))
+		}
+		fmt.Println()
+
+		if len(result.WorkPackages) == 0 {
+			ui.PrintInfo("Nenhum Work Package nesta consulta")
+			return
+		}
+
+		renderQueryTable(result)
+
+		if result.Total > len(result.WorkPackages) {
+			fmt.Println()
+			fmt.Println(muted.Render(fmt.Sprintf("Exibindo %d de %d", len(result.WorkPackages), result.Total)))
+		}
+	},
+}
+
+// renderQueryTable exibe os work packages com as colunas da consulta. Sem
+// colunas definidas, usa a linha padrão das listagens.
+func renderQueryTable(result *openproject.QueryResult) {
+	query := result.Query
+	if len(query.Links.Columns) == 0 {
+		for _, wp := range result.WorkPackages {
+			fmt.Println(workPackageRow(wp))
+		}
+		return
+	}
+
+	columns := make([]string, len(query.Links.Columns))
+	widths := make([]int, len(columns))
+	for i := range columns {
+		columns[i] = query.Column(i)
+		widths[i] = lipgloss.Width(query.Links.Columns[i].Title)
+	}
+
+	rows := make([][]string, len(result.WorkPackages))
+	for r := range result.WorkPackages {
+		rows[r] = make([]string, len(columns))
+		for i, col := range columns {
+			value := queryColumnValue(&result.WorkPackages[r], col)
+			if col == "subject" {
+				value = truncate(value, 60)
+			} else {
+				value = truncate(value, 30)
+			}
+			rows[r][i] = value
+			widths[i] = max(widths[i], lipgloss.Width(value))
+		}
+	}
+	for i, col := range columns {
+		if col == "status" {
+			widths[i] += 2 // padding do statusStyle
+		}
+	}
+
+	headerStyle := lipgloss.NewStyle().Bold(true).Foreground(mutedColor)
+	cells := make([]string, len(columns))
+	for i := range columns {
+		cells[i] = headerStyle.Width(widths[i]).Render(query.Links.Columns[i].Title)
+	}
+	fmt.Println(strings.Join(cells, "  "))
+
+	for _, row := range rows {
+		for i, col := range columns {
+			style := valueStyle
+			switch col {
+			case "id":
+				style = idStyle
+			case "subject":
+				style = subjectStyle
+			case "status":
+				style = statusStyle(row[i])
+			}
+			cells[i] = style.Width(widths[i]).Render(row[i])
+		}
+		fmt.Println(strings.Join(cells, "  "))
+	}
+}
+
+// queryColumnValue retorna o valor de uma coluna da consulta como texto.
+func queryColumnValue(wp *openproject.WorkPackage, column string) string {
+	switch column {
+	case "id":
+		return fmt.Sprintf("#%d", wp.ID)
+	case "subject":
+		return wp.Subject
+	case "type":
+		return wp.Links.Type.Title
+	case "status":
+		return wp.Links.Status.Title
+	case "priority":
+		return wp.Links.Priority.Title
+	case "assignee":
+		return wp.Links.Assignee.Title
+	case "responsible":
+		return wp.Links.Responsible.Title
+	case "author":
+		return wp.Links.Author.Title
+	case "version":
+		return wp.Links.Version.Title
+	case "category":
+		return wp.Links.Category.Title
+	case "project":
+		return wp.Links.Project.Title
+	case "startDate":
+		return formatDay(wp.StartDate)
+	case "dueDate":
+		return formatDay(wp.DueDate)
+	case "createdAt":
+		return formatDate(wp.CreatedAt)
+	case "updatedAt":
+		return formatDate(wp.UpdatedAt)
+	case "estimatedTime":
+		return openproject.FormatHours(wp.EstimatedTime)
+	case "remainingTime":
+		return openproject.FormatHours(wp.RemainingTime)
+	case "spentTime":
+		return openproject.FormatHours(wp.SpentTime)
+	case "percentageDone":
+		if wp.PercentageDone != nil {
+			return fmt.Sprintf("%d%%", *wp.PercentageDone)
+		}
+	case "storyPoints":
+		if wp.StoryPoints != nil {
+			return fmt.Sprint(*wp.StoryPoints)
+		}
+	default:
+		return wp.CustomFields[column]
+	}
+	return ""
+}
+
+func queryClient() (*config.Config, *openproject.Client) {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+		os.Exit(1)
+	}
+
+	return cfg, openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+}
+
+func init() {
+	queryCmd.AddCommand(queryListCmd)
+	queryCmd.AddCommand(queryRunCmd)
+	rootCmd.AddCommand(queryCmd)
+}
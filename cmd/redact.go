@@ -0,0 +1,70 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/redact"
+)
+
+var noRedact bool
+
+func newRedactor(cfg *config.Config) (*redact.Redactor, error) {
+	custom := make([]redact.CustomPattern, 0, len(cfg.Redact.Patterns))
+	for _, p := range cfg.Redact.Patterns {
+		custom = append(custom, redact.CustomPattern{Name: p.Name, Pattern: p.Pattern})
+	}
+	return redact.New(custom)
+}
+
+// redactTexts mascara segredos e dados pessoais em cada texto, a menos que a
+// redação esteja desativada (--no-redact ou redact.disabled).
+func redactTexts(cfg *config.Config, texts ...*string) ([]redact.Finding, error) {
+	if noRedact || cfg.Redact.Disabled {
+		return nil, nil
+	}
+
+	redactor, err := newRedactor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var findings []redact.Finding
+	for _, text := range texts {
+		var found []redact.Finding
+		*text, found = redactor.Redact(*text)
+		findings = append(findings, found...)
+	}
+	return findings, nil
+}
+
+// draftFilter mascara o rascunho exibido em streaming com as mesmas regras de
+// redactTexts, para que os segredos não apareçam na tela antes da redação.
+// Retorna nil quando a redação está desativada.
+func draftFilter(cfg *config.Config) (func(string) (string, bool), error) {
+	if noRedact || cfg.Redact.Disabled {
+		return nil, nil
+	}
+
+	redactor, err := newRedactor(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return redactor.Lines().Line, nil
+}
+
+func printRedactions(findings []redact.Finding) {
+	if len(findings) == 0 {
+		return
+	}
+
+	warn := lipgloss.NewStyle().Bold(true).Foreground(warningColor)
+	ruleStyle := lipgloss.NewStyle().Foreground(warningColor).Width(14)
+	valueStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+	fmt.Println(warn.Render(fmt.Sprintf("%d dado(s) sensível(is) mascarado(s):", len(findings))))
+	for _, f := range findings {
+		fmt.Printf("  %s %s\n", ruleStyle.Render(f.Rule), valueStyle.Render(f.Preview()))
+	}
+}
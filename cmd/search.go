@@ -0,0 +1,249 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	searchProject string
+	searchStatus  string
+	searchLimit   int
+
+	highlightStyle = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#111827")).
+			Background(lipgloss.Color("#FCD34D"))
+)
+
+var searchCmd = &cobra.Command{
+	Use:   "search <texto>",
+	Short: "Busca Work Packages pelo título, descrição e comentários",
+	Long: `Busca o texto nos Work Packages (título, descrição e comentários) e lista os
+resultados do mais relevante para o menos relevante: ocorrências no título
+pesam mais que na descrição e, no empate, vem o atualizado mais recentemente.
+
+Por padrão a busca inclui Work Packages fechados e se limita ao projeto
+configurado; use --project all para buscar em todos os projetos.
+
+Exemplos:
+  op search "timeout pix"
+  op search checkout --status open
+  op search "erro 500" --project all -n 50`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		query := strings.TrimSpace(strings.Join(args, " "))
+		if query == "" {
+			fmt.Fprintln(os.Stderr, "Informe o texto a buscar")
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		project := cfg.Project
+		allProjects := strings.EqualFold(searchProject, "all")
+		switch {
+		case allProjects:
+			project = ""
+		case searchProject != "":
+			project = searchProject
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, project)
+
+		ui.StartSpinner("Buscando...")
+		filters, err := buildFilters(client, map[string]string{"status": searchStatus})
+		var wps []openproject.WorkPackage
+		if err == nil {
+			wps, err = client.ListAllWorkPackages(append(filters, openproject.SearchFilter(query))...)
+		}
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro na busca: %v\n", err)
+			os.Exit(1)
+		}
+
+		header := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(primaryColor).
+			MarginBottom(1)
+
+		if len(wps) == 0 {
+			ui.PrintInfo(fmt.Sprintf("Nenhum Work Package encontrado para %q", query))
+			return
+		}
+
+		terms := searchTerms(query)
+		results := rankSearchResults(wps, query, terms)
+
+		fmt.Println(header.Render(fmt.Sprintf("Resultados para %q (%d)", query, len(results))))
+
+		muted := lipgloss.NewStyle().Foreground(mutedColor)
+		now := time.Now()
+		for i, wp := range results {
+			if searchLimit > 0 && i == searchLimit {
+				fmt.Println()
+				fmt.Println(muted.Render(fmt.Sprintf("… e mais %d. Use -n 0 para ver todos.", len(results)-searchLimit)))
+				break
+			}
+
+			subject := highlightTerms(wp.Subject, terms, subjectStyle)
+			line := workPackageRowWith(wp, subject)
+			if allProjects && wp.Links.Project.Title != "" {
+				line += "  " + muted.Render("["+wp.Links.Project.Title+"]")
+			}
+			fmt.Println(line)
+
+			detail := muted.Render("atualizado " + timeAgo(wp.UpdatedAt, now))
+			if snippet := searchSnippet(wp.Description.Raw, terms, 40); snippet != "" {
+				detail = highlightTerms(snippet, terms, muted) + "  " + detail
+			} else if matchCount(wp.Subject, terms) == 0 {
+				detail = muted.Render("(encontrado nos comentários)") + "  " + detail
+			}
+			fmt.Println("        " + detail)
+		}
+	},
+}
+
+// searchTerms separa a busca em termos em minúsculas, sem repetir.
+func searchTerms(query string) []string {
+	var terms []string
+	seen := map[string]bool{}
+	for _, t := range strings.Fields(strings.ToLower(query)) {
+		if !seen[t] {
+			seen[t] = true
+			terms = append(terms, t)
+		}
+	}
+	return terms
+}
+
+// rankSearchResults ordena por relevância: o ID ou a frase inteira no título
+// valem mais, depois cada termo no título e, por último, na descrição. O
+// empate é decidido pela atualização mais recente.
+func rankSearchResults(wps []openproject.WorkPackage, query string, terms []string) []openproject.WorkPackage {
+	phrase := strings.ToLower(strings.TrimSpace(query))
+
+	score := make(map[int]int, len(wps))
+	for _, wp := range wps {
+		s := 0
+		if strconv.Itoa(wp.ID) == strings.TrimPrefix(phrase, "#") {
+			s += 100
+		}
+		subject := strings.ToLower(wp.Subject)
+		if len(terms) > 1 && strings.Contains(subject, phrase) {
+			s += 10
+		}
+		for _, t := range terms {
+			if strings.Contains(subject, t) {
+				s += 5
+			}
+		}
+		s += min(matchCount(wp.Description.Raw, terms), 5)
+		score[wp.ID] = s
+	}
+
+	sorted := append([]openproject.WorkPackage(nil), wps...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		a, b := sorted[i], sorted[j]
+		if score[a.ID] != score[b.ID] {
+			return score[a.ID] > score[b.ID]
+		}
+		return a.UpdatedAt > b.UpdatedAt
+	})
+	return sorted
+}
+
+// matchRanges retorna os intervalos (em runas) de text onde algum termo
+// aparece, sem diferenciar maiúsculas, já ordenados e sem sobreposição.
+func matchRanges(text []rune, terms []string) [][2]int {
+	lower := []rune(strings.ToLower(string(text)))
+	if len(lower) != len(text) {
+		// ToLower mudou o número de runas; sem como mapear as posições
+		return nil
+	}
+
+	var ranges [][2]int
+	for i := 0; i < len(lower); {
+		best := 0
+		for _, t := range terms {
+			tr := []rune(t)
+			if len(tr) > best && i+len(tr) <= len(lower) && string(lower[i:i+len(tr)]) == t {
+				best = len(tr)
+			}
+		}
+		if best == 0 {
+			i++
+			continue
+		}
+		ranges = append(ranges, [2]int{i, i + best})
+		i += best
+	}
+	return ranges
+}
+
+func matchCount(text string, terms []string) int {
+	return len(matchRanges([]rune(text), terms))
+}
+
+// highlightTerms renderiza text com base, destacando os termos da busca.
+func highlightTerms(text string, terms []string, base lipgloss.Style) string {
+	runes := []rune(text)
+
+	var b strings.Builder
+	last := 0
+	for _, r := range matchRanges(runes, terms) {
+		if r[0] > last {
+			b.WriteString(base.Render(string(runes[last:r[0]])))
+		}
+		b.WriteString(highlightStyle.Render(string(runes[r[0]:r[1]])))
+		last = r[1]
+	}
+	if last < len(runes) {
+		b.WriteString(base.Render(string(runes[last:])))
+	}
+	return b.String()
+}
+
+// searchSnippet extrai um trecho de uma linha da descrição em volta da
+// primeira ocorrência, com até context runas de cada lado.
+func searchSnippet(text string, terms []string, context int) string {
+	runes := []rune(strings.Join(strings.Fields(text), " "))
+	ranges := matchRanges(runes, terms)
+	if len(ranges) == 0 {
+		return ""
+	}
+
+	start := max(ranges[0][0]-context, 0)
+	end := min(ranges[0][1]+context, len(runes))
+
+	snippet := string(runes[start:end])
+	if start > 0 {
+		snippet = "…" + snippet
+	}
+	if end < len(runes) {
+		snippet += "…"
+	}
+	return snippet
+}
+
+func init() {
+	searchCmd.Flags().StringVar(&searchProject, "project", "", "Projeto onde buscar (padrão: o configurado; all para todos)")
+	searchCmd.Flags().StringVar(&searchStatus, "status", "all", "Status: all, open, closed ou o nome de um status")
+	searchCmd.Flags().IntVarP(&searchLimit, "limit", "n", 20, "Máximo de resultados exibidos (0 para todos)")
+	rootCmd.AddCommand(searchCmd)
+}
@@ -0,0 +1,96 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guialveess/opencli/internal/cache"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+)
+
+// workPackageSnapshot é a cópia local do resultado de uma consulta, usada com
+// --offline e como reserva quando o servidor não responde.
+type workPackageSnapshot struct {
+	FetchedAt    time.Time                 `json:"fetchedAt"`
+	WorkPackages []openproject.WorkPackage `json:"workPackages"`
+	Relations    []openproject.Relation    `json:"relations,omitempty"`
+}
+
+// snapshotName identifica a consulta pelos filtros como o usuário os digitou,
+// para que --offline funcione sem precisar resolver nomes no servidor.
+func snapshotName(project string, filterFields map[string]string) string {
+	pairs := make([]string, 0, len(filterFields))
+	for k, v := range filterFields {
+		pairs = append(pairs, k+"="+strings.ToLower(v))
+	}
+	sort.Strings(pairs)
+
+	sum := sha256.Sum256([]byte(strings.Join(pairs, "\x00")))
+	return fmt.Sprintf("workpackages-%s-%x.json", project, sum[:8])
+}
+
+// fetchWorkPackages lista os work packages que atendem aos filtros (e, se
+// pedido, as relações entre eles) e guarda o resultado em cache. Com offline,
+// ou se o servidor falhar e houver cache, usa a última cópia salva.
+func fetchWorkPackages(client *openproject.Client, filterFields map[string]string, withRelations, offline bool) (*workPackageSnapshot, error) {
+	name := snapshotName(client.Project, filterFields)
+
+	if offline {
+		var snap workPackageSnapshot
+		if err := cache.Load(name, &snap); err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				return nil, fmt.Errorf("não há cache para esta consulta; rode uma vez sem --offline")
+			}
+			return nil, err
+		}
+		fmt.Fprintln(os.Stderr, ui.MutedStyle.Render(fmt.Sprintf("Usando cache de %s", snap.FetchedAt.Format("02/01/2006 15:04"))))
+		return &snap, nil
+	}
+
+	snap, err := fetchSnapshot(client, filterFields, withRelations)
+	if err != nil {
+		var cached workPackageSnapshot
+		if cacheErr := cache.Load(name, &cached); cacheErr == nil {
+			fmt.Fprintln(os.Stderr, ui.MutedStyle.Render(fmt.Sprintf("Não foi possível consultar o servidor; usando cache de %s", cached.FetchedAt.Format("02/01/2006 15:04"))))
+			return &cached, nil
+		}
+		return nil, err
+	}
+
+	// o cache é só uma reserva, falhar ao salvar não invalida o resultado
+	_ = cache.Save(name, snap)
+	return snap, nil
+}
+
+func fetchSnapshot(client *openproject.Client, filterFields map[string]string, withRelations bool) (*workPackageSnapshot, error) {
+	filters, err := buildFilters(client, filterFields)
+	if err != nil {
+		return nil, err
+	}
+
+	wps, err := client.ListAllWorkPackages(filters...)
+	if err != nil {
+		return nil, err
+	}
+
+	snap := &workPackageSnapshot{FetchedAt: time.Now(), WorkPackages: wps}
+
+	if withRelations && len(wps) > 0 {
+		ids := make([]int, len(wps))
+		for i, wp := range wps {
+			ids[i] = wp.ID
+		}
+		if snap.Relations, err = client.ListRelations(ids); err != nil {
+			return nil, err
+		}
+	}
+
+	return snap, nil
+}
@@ -0,0 +1,381 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/guialveess/opencli/internal/ai"
+	"github.com/guialveess/opencli/internal/clipboard"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	standupSince string
+	standupUser  string
+	standupAI    bool
+	standupCopy  bool
+)
+
+var standupCmd = &cobra.Command{
+	Use:   "standup",
+	Short: "Resume o que você fez no período, pronto para o daily",
+	Long: `Compila o que mudou no período: work packages que você criou, moveu,
+comentou ou em que lançou horas, a partir do histórico e dos lançamentos de
+horas do OpenProject.
+
+--since aceita yesterday/ontem (último dia útil), today/hoje, week/semana
+(desde segunda), Nd (últimos N dias) ou uma data AAAA-MM-DD.
+
+Exemplos:
+  op standup
+  op standup --since week
+  op standup --user ana --since 2026-10-01
+  op standup --ai --copy`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		since, period, err := parseSince(standupSince, time.Now())
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+
+		ui.StartSpinner("Obtendo usuário...")
+		user, err := client.FindUser(standupUser)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		ui.StartSpinner("Buscando atividades...")
+		entries, err := collectStandup(client, user.ID, since)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao buscar atividades: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(entries) == 0 {
+			ui.PrintInfo(fmt.Sprintf("Nenhuma atividade de %s %s", user.Name, period))
+			return
+		}
+
+		text := formatStandup(user.Name, period, entries)
+		fmt.Println(text)
+
+		if standupAI {
+			summary, err := summarizeStandup(cfg, user.Name, period, text)
+			if err != nil {
+				ui.PrintError(err.Error())
+				os.Exit(1)
+			}
+			text = summary
+		}
+
+		if standupCopy {
+			if err := clipboard.WriteText(text); err != nil {
+				ui.PrintError(fmt.Sprintf("Erro ao copiar para o clipboard: %v", err))
+				return
+			}
+			ui.PrintSuccess("Copiado para o clipboard")
+		}
+	},
+}
+
+// standupEntry é tudo o que o usuário fez em um work package no período.
+type standupEntry struct {
+	ID            int
+	Subject       string
+	Status        string
+	Created       bool
+	StatusChanges []string
+	Comments      []string
+	OtherChanges  int
+	Spent         time.Duration
+}
+
+func (e *standupEntry) empty() bool {
+	return !e.Created && len(e.StatusChanges) == 0 && len(e.Comments) == 0 && e.OtherChanges == 0 && e.Spent == 0
+}
+
+// collectStandup junta o histórico dos work packages alterados no período com
+// os lançamentos de horas do usuário.
+func collectStandup(client *openproject.Client, userID int, since time.Time) ([]*standupEntry, error) {
+	wps, err := client.ListAllWorkPackages(openproject.AnyStatusFilter(), openproject.UpdatedSinceFilter(since))
+	if err != nil {
+		return nil, err
+	}
+
+	project, err := client.GetProject()
+	if err != nil {
+		return nil, err
+	}
+
+	entries, err := client.ListTimeEntries(
+		openproject.Filter{Name: "user", Operator: "=", Values: []string{strconv.Itoa(userID)}},
+		openproject.Filter{Name: "project", Operator: "=", Values: []string{strconv.Itoa(project.ID)}},
+		openproject.Filter{Name: "spentOn", Operator: "<>d", Values: []string{since.Format("2006-01-02"), ""}},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	byID := map[int]*standupEntry{}
+	for _, wp := range wps {
+		byID[wp.ID] = &standupEntry{ID: wp.ID, Subject: wp.Subject, Status: wp.Links.Status.Title}
+	}
+
+	var mu sync.Mutex
+	var firstErr error
+	forEachConcurrently(len(wps), 4, func(i int) {
+		activities, err := client.ListActivities(wps[i].ID)
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		applyActivities(byID[wps[i].ID], activities, userID, since)
+	})
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	for _, te := range entries {
+		id := te.Links.WorkPackage.ID()
+		if id == 0 {
+			continue
+		}
+		entry, ok := byID[id]
+		if !ok {
+			entry = &standupEntry{ID: id, Subject: te.Links.WorkPackage.Title}
+			byID[id] = entry
+		}
+		hours, _ := openproject.ParseDuration(te.Hours)
+		entry.Spent += hours
+	}
+
+	var result []*standupEntry
+	for _, e := range byID {
+		if !e.empty() {
+			result = append(result, e)
+		}
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].ID < result[j].ID })
+
+	return result, nil
+}
+
+var (
+	markdownEmphasis = regexp.MustCompile(`[*_]{1,2}`)
+	// "Status changed from New to In Progress" / "Status alterado de New para In Progress"
+	statusChange = regexp.MustCompile(`(?i)^status\b.*?\b(?:from|de)\s+(.+?)\s+(?:to|para)\s+(.+?)\.?$`)
+)
+
+func applyActivities(entry *standupEntry, activities []openproject.Activity, userID int, since time.Time) {
+	for _, a := range activities {
+		if a.Links.User.ID() != userID {
+			continue
+		}
+		created, err := time.Parse(time.RFC3339, a.CreatedAt)
+		if err != nil || created.Before(since) {
+			continue
+		}
+
+		if a.Version == 1 {
+			entry.Created = true
+		}
+		if comment := strings.TrimSpace(a.Comment.Raw); comment != "" {
+			entry.Comments = append(entry.Comments, comment)
+		}
+
+		for _, d := range a.Details {
+			detail := strings.TrimSpace(markdownEmphasis.ReplaceAllString(d.Raw, ""))
+			if m := statusChange.FindStringSubmatch(detail); m != nil {
+				entry.StatusChanges = append(entry.StatusChanges, m[1]+" → "+m[2])
+			} else if a.Version != 1 {
+				entry.OtherChanges++
+			}
+		}
+	}
+}
+
+// formatStandup gera o texto em markdown simples, uma linha por work package,
+// para colar no chat.
+func formatStandup(user, period string, entries []*standupEntry) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "*Standup de %s %s*\n\n", user, period)
+
+	var total time.Duration
+	for _, e := range entries {
+		var parts []string
+		if e.Created {
+			parts = append(parts, "criou")
+		}
+		parts = append(parts, e.StatusChanges...)
+		switch len(e.Comments) {
+		case 0:
+		case 1:
+			parts = append(parts, fmt.Sprintf("comentou: “%s”", truncate(firstLine(e.Comments[0]), 60)))
+		default:
+			parts = append(parts, fmt.Sprintf("%d comentários", len(e.Comments)))
+		}
+		if e.OtherChanges > 0 && len(e.StatusChanges) == 0 && !e.Created {
+			parts = append(parts, "atualizou")
+		}
+		if e.Spent > 0 {
+			parts = append(parts, openproject.Hours(e.Spent))
+			total += e.Spent
+		}
+
+		status := ""
+		if e.Status != "" {
+			status = fmt.Sprintf(" [%s]", e.Status)
+		}
+		fmt.Fprintf(&b, "- #%d %s%s: %s\n", e.ID, e.Subject, status, strings.Join(parts, " · "))
+	}
+
+	if total > 0 {
+		fmt.Fprintf(&b, "\nTotal: %s registradas\n", openproject.Hours(total))
+	}
+
+	return strings.TrimRight(b.String(), "\n")
+}
+
+func summarizeStandup(cfg *config.Config, user, period, activity string) (string, error) {
+	provider, err := newAIProvider(cfg)
+	if err != nil {
+		return "", err
+	}
+
+	promptsDir := ""
+	if dir, err := config.Dir(); err == nil {
+		promptsDir = filepath.Join(dir, "prompts")
+	}
+
+	prompt, err := ai.RenderStandupPrompt(cfg.Prompts, promptsDir, ai.StandupData{
+		Lang:     cfg.AI.Language,
+		User:     user,
+		Period:   period,
+		Activity: activity,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	fmt.Println()
+	ui.StartSpinner("IA resumindo...")
+	started := false
+	summary, err := provider.Generate(ctx, prompt, nil, func(token string) {
+		if !started {
+			ui.StopSpinner()
+			fmt.Println(ui.LabelStyle.Render("Resumo:"))
+			started = true
+		}
+		fmt.Print(token)
+	})
+	ui.StopSpinner()
+	if started {
+		fmt.Println()
+	}
+
+	if errors.Is(err, context.Canceled) {
+		return "", fmt.Errorf("resumo cancelado")
+	}
+	if err != nil {
+		printAIHints(cfg.AI)
+		return "", fmt.Errorf("erro ao gerar resumo: %w", err)
+	}
+
+	return strings.TrimSpace(summary), nil
+}
+
+// parseSince interpreta --since e retorna o início do período e uma descrição
+// para o cabeçalho, ex: "desde ontem (17/10)".
+func parseSince(value string, now time.Time) (time.Time, string, error) {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	switch strings.ToLower(value) {
+	case "", "yesterday", "ontem":
+		// na segunda, "ontem" é a sexta-feira
+		day := today.AddDate(0, 0, -1)
+		for day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+			day = day.AddDate(0, 0, -1)
+		}
+		return day, fmt.Sprintf("desde %s (%s)", weekdayName(day, today), day.Format("02/01")), nil
+	case "today", "hoje":
+		return today, "de hoje", nil
+	case "week", "semana":
+		offset := (int(today.Weekday()) + 6) % 7
+		monday := today.AddDate(0, 0, -offset)
+		return monday, fmt.Sprintf("desta semana (desde %s)", monday.Format("02/01")), nil
+	}
+
+	if days, ok := strings.CutSuffix(strings.ToLower(value), "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n > 0 {
+			return today.AddDate(0, 0, -n), fmt.Sprintf("dos últimos %d dias", n), nil
+		}
+	}
+
+	if t, err := time.ParseInLocation("2006-01-02", value, now.Location()); err == nil {
+		return t, fmt.Sprintf("desde %s", t.Format("02/01/2006")), nil
+	}
+
+	return time.Time{}, "", fmt.Errorf("--since inválido: %s (use yesterday, today, week, Nd ou AAAA-MM-DD)", value)
+}
+
+func weekdayName(day, today time.Time) string {
+	if day.Equal(today.AddDate(0, 0, -1)) {
+		return "ontem"
+	}
+	names := []string{"domingo", "segunda", "terça", "quarta", "quinta", "sexta", "sábado"}
+	return names[day.Weekday()]
+}
+
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSpace(line)
+}
+
+func truncate(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return strings.TrimSpace(string(r[:n-1])) + "…"
+}
+
+func init() {
+	standupCmd.Flags().StringVar(&standupSince, "since", "yesterday", "Início do período: yesterday, today, week, Nd ou AAAA-MM-DD")
+	standupCmd.Flags().StringVar(&standupUser, "user", "me", "Usuário: me, ID, login ou nome")
+	standupCmd.Flags().BoolVar(&standupAI, "ai", false, "Gera também um resumo em texto corrido com a IA configurada")
+	standupCmd.Flags().BoolVar(&standupCopy, "copy", false, "Copia o resultado (ou o resumo da IA) para o clipboard")
+	standupCmd.Flags().StringVarP(&aiModel, "model", "m", "", "Modelo usado no resumo (padrão: ai.model)")
+	rootCmd.AddCommand(standupCmd)
+}
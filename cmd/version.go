@@ -0,0 +1,313 @@
+package cmd
+
+import (
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var versionCmd = &cobra.Command{
+	Use:     "version",
+	Aliases: []string{"sprint"},
+	Short:   "Gerencia versões/sprints do projeto",
+	Long:    "Comandos para acompanhar as versões (sprints) do projeto configurado no OpenProject.",
+}
+
+var versionListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "Lista as versões do projeto",
+	Long:  "Lista as versões do projeto com estado (open, locked, closed) e datas.",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		client := versionClient()
+
+		ui.StartSpinner("Carregando versões...")
+		versions, err := client.ListVersions()
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar versões: %v\n", err)
+			os.Exit(1)
+		}
+
+		header := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(primaryColor).
+			MarginBottom(1)
+
+		fmt.Println(header.Render(fmt.Sprintf("Versões (%d)", len(versions))))
+
+		if len(versions) == 0 {
+			ui.PrintInfo("Nenhuma versão cadastrada no projeto")
+			return
+		}
+
+		width := 0
+		for _, v := range versions {
+			width = max(width, lipgloss.Width(v.Name))
+		}
+		nameStyle := subjectStyle.Width(width)
+		datesStyle := lipgloss.NewStyle().Foreground(mutedColor)
+
+		for _, v := range versions {
+			id := idStyle.Render(fmt.Sprintf("#%-4d", v.ID))
+			status := versionStatusStyle(v.Status).Render(fmt.Sprintf("%-6s", v.Status))
+			fmt.Printf("%s  %s  %s  %s\n", id, status, nameStyle.Render(v.Name), datesStyle.Render(versionDates(&v)))
+		}
+	},
+}
+
+var versionShowCmd = &cobra.Command{
+	Use:   "show <nome|id>",
+	Short: "Mostra o progresso de uma versão",
+	Long: `Mostra o progresso de uma versão/sprint: work packages por status,
+estimativa restante e a comparação com a linha ideal do burndown.
+
+Exemplos:
+  op version show "Sprint 42"
+  op version show 12`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client := versionClient()
+
+		ui.StartSpinner("Carregando versão...")
+		version, err := client.FindVersion(args[0])
+		if err != nil {
+			ui.StopSpinner()
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		workPackages, err := client.ListAllWorkPackages(openproject.VersionFilter(version.ID), openproject.AnyStatusFilter())
+		if err != nil {
+			ui.StopSpinner()
+			fmt.Fprintf(os.Stderr, "Erro ao listar Work Packages: %v\n", err)
+			os.Exit(1)
+		}
+
+		statuses, err := client.ListStatuses()
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar status: %v\n", err)
+			os.Exit(1)
+		}
+
+		closed := make(map[string]bool, len(statuses))
+		for _, s := range statuses {
+			closed[s.Name] = s.IsClosed
+		}
+
+		fmt.Println(renderVersionProgress(version, summarizeVersion(workPackages, closed)))
+	},
+}
+
+// versionSummary agrega os work packages de uma versão para o resumo de progresso.
+type versionSummary struct {
+	Total     int
+	Done      int
+	ByStatus  map[string]int
+	Estimated time.Duration
+	Remaining time.Duration
+}
+
+// summarizeVersion conta os work packages por status e soma as estimativas.
+// O restante de um work package aberto é o remainingTime ou, se não houver,
+// o estimatedTime; fechados não contam como restante.
+func summarizeVersion(wps []openproject.WorkPackage, closed map[string]bool) versionSummary {
+	summary := versionSummary{Total: len(wps), ByStatus: map[string]int{}}
+
+	for _, wp := range wps {
+		status := wp.Links.Status.Title
+		summary.ByStatus[status]++
+
+		estimated, _ := openproject.ParseDuration(wp.EstimatedTime)
+		summary.Estimated += estimated
+
+		if closed[status] {
+			summary.Done++
+			continue
+		}
+
+		if remaining, err := openproject.ParseDuration(wp.RemainingTime); err == nil {
+			summary.Remaining += remaining
+		} else {
+			summary.Remaining += estimated
+		}
+	}
+
+	return summary
+}
+
+func renderVersionProgress(v *openproject.Version, s versionSummary) string {
+	var b strings.Builder
+
+	title := titleStyle.Render(v.Name) + "  " + versionStatusStyle(v.Status).Render(v.Status)
+	b.WriteString(headerBox.Render(title) + "\n")
+
+	muted := lipgloss.NewStyle().Foreground(mutedColor)
+	if dates := versionDates(v); dates != "" {
+		line := dates
+		if left, ok := daysLeft(v); ok {
+			line += " · " + left
+		}
+		b.WriteString(muted.Render(line) + "\n\n")
+	}
+
+	percent := 0
+	if s.Total > 0 {
+		percent = s.Done * 100 / s.Total
+	}
+	b.WriteString(propLabelStyle.Render("Progresso"))
+	b.WriteString(progressBar(s.Done, s.Total, 24))
+	b.WriteString(propValueStyle.Render(fmt.Sprintf("  %d/%d concluídos (%d%%)", s.Done, s.Total, percent)) + "\n\n")
+
+	if s.Total == 0 {
+		b.WriteString(muted.Render("Nenhum work package nesta versão") + "\n")
+		return b.String()
+	}
+
+	names := make([]string, 0, len(s.ByStatus))
+	width := 0
+	for name := range s.ByStatus {
+		names = append(names, name)
+		width = max(width, lipgloss.Width(name))
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if s.ByStatus[names[i]] != s.ByStatus[names[j]] {
+			return s.ByStatus[names[i]] > s.ByStatus[names[j]]
+		}
+		return names[i] < names[j]
+	})
+
+	b.WriteString(lipgloss.NewStyle().Bold(true).Foreground(primaryColor).Render("Por status") + "\n")
+	for _, name := range names {
+		count := s.ByStatus[name]
+		label := statusStyle(name).Render(fmt.Sprintf("%-*s", width, name))
+		bar := muted.Render(strings.Repeat("▪", count))
+		fmt.Fprintf(&b, "  %s %3d  %s\n", label, count, bar)
+	}
+	b.WriteString("\n")
+
+	if s.Estimated > 0 || s.Remaining > 0 {
+		b.WriteString(propLabelStyle.Render("Estimado") + propValueStyle.Render(openproject.Hours(s.Estimated)) + "\n")
+		b.WriteString(propLabelStyle.Render("Restante") + propValueStyle.Render(openproject.Hours(s.Remaining)) + "\n")
+
+		if ideal, ok := idealRemaining(v, s.Estimated); ok {
+			style := lipgloss.NewStyle().Foreground(successColor)
+			note := "dentro do previsto"
+			if s.Remaining > ideal {
+				style = lipgloss.NewStyle().Foreground(errorColor)
+				note = fmt.Sprintf("%s acima da linha ideal", openproject.Hours(s.Remaining-ideal))
+			}
+			b.WriteString(propLabelStyle.Render("Ideal hoje") + propValueStyle.Render(openproject.Hours(ideal)) + "  " + style.Render(note) + "\n")
+		}
+	}
+
+	return b.String()
+}
+
+// idealRemaining calcula quanto deveria restar hoje se o trabalho estimado
+// fosse consumido de forma linear entre o início e o fim da versão.
+func idealRemaining(v *openproject.Version, estimated time.Duration) (time.Duration, bool) {
+	start, err1 := time.Parse("2006-01-02", v.StartDate)
+	end, err2 := time.Parse("2006-01-02", v.EndDate)
+	if err1 != nil || err2 != nil || !end.After(start) || estimated == 0 {
+		return 0, false
+	}
+
+	// o último dia da versão também é dia de trabalho
+	end = end.AddDate(0, 0, 1)
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	elapsed := today.Sub(start).Hours() / end.Sub(start).Hours()
+	elapsed = math.Min(math.Max(elapsed, 0), 1)
+
+	return time.Duration(float64(estimated) * (1 - elapsed)), true
+}
+
+func daysLeft(v *openproject.Version) (string, bool) {
+	end, err := time.Parse("2006-01-02", v.EndDate)
+	if err != nil || v.Status == "closed" {
+		return "", false
+	}
+
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	days := int(end.Sub(today).Hours() / 24)
+
+	switch {
+	case days < 0:
+		return fmt.Sprintf("encerrada há %d dia(s)", -days), true
+	case days == 0:
+		return "termina hoje", true
+	default:
+		return fmt.Sprintf("%d dia(s) restante(s)", days), true
+	}
+}
+
+func versionDates(v *openproject.Version) string {
+	switch {
+	case v.StartDate != "" && v.EndDate != "":
+		return formatDay(v.StartDate) + " → " + formatDay(v.EndDate)
+	case v.EndDate != "":
+		return "até " + formatDay(v.EndDate)
+	case v.StartDate != "":
+		return "desde " + formatDay(v.StartDate)
+	}
+	return ""
+}
+
+func progressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = done * width / total
+	}
+
+	return lipgloss.NewStyle().Foreground(successColor).Render(strings.Repeat("█", filled)) +
+		lipgloss.NewStyle().Foreground(mutedColor).Render(strings.Repeat("░", width-filled))
+}
+
+func versionStatusStyle(status string) lipgloss.Style {
+	base := lipgloss.NewStyle().Bold(true)
+
+	switch status {
+	case "open":
+		return base.Foreground(successColor)
+	case "locked":
+		return base.Foreground(warningColor)
+	default:
+		return base.Foreground(mutedColor)
+	}
+}
+
+func versionID(v *openproject.Version) int {
+	if v == nil {
+		return 0
+	}
+	return v.ID
+}
+
+func versionClient() *openproject.Client {
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+		os.Exit(1)
+	}
+
+	return openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+}
+
+func init() {
+	versionCmd.AddCommand(versionListCmd)
+	versionCmd.AddCommand(versionShowCmd)
+	rootCmd.AddCommand(versionCmd)
+}
@@ -0,0 +1,266 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/spf13/cobra"
+)
+
+// minWatchInterval evita que um --watch=1s vire carga no servidor.
+const minWatchInterval = 5 * time.Second
+
+var watchInterval time.Duration
+
+// addWatchFlag registra --watch nos comandos que podem ficar atualizando a
+// tela. Sem valor usa 30s; outro intervalo vai com "=", ex: --watch=1m.
+func addWatchFlag(cmd *cobra.Command) {
+	cmd.Flags().DurationVar(&watchInterval, "watch", 0, "Atualiza a tela periodicamente (padrão 30s; ex: --watch=1m) até o Ctrl+C")
+	cmd.Flags().Lookup("watch").NoOptDefVal = "30s"
+	cmd.Args = watchArgs(cmd.Args)
+}
+
+// watchArgs detecta o "--watch 1m" sem "=": como o valor é opcional, o 1m
+// sobra como argumento posicional e o intervalo ficaria no padrão.
+func watchArgs(validate cobra.PositionalArgs) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if cmd.Flags().Changed("watch") {
+			for _, arg := range args {
+				if _, err := time.ParseDuration(arg); err == nil {
+					return fmt.Errorf("use --watch=%s (com \"=\") para definir o intervalo", arg)
+				}
+			}
+		}
+		if validate == nil {
+			return nil
+		}
+		return validate(cmd, args)
+	}
+}
+
+func checkWatchInterval() error {
+	if watchInterval != 0 && watchInterval < minWatchInterval {
+		return fmt.Errorf("--watch deve ser de pelo menos %s", minWatchInterval)
+	}
+	return nil
+}
+
+// watchLoop redesenha a tela a cada intervalo até o Ctrl+C. A cada ciclo
+// pergunta ao feed se algo mudou (consulta barata, filtrada por updatedAt) e
+// só então chama refresh para buscar tudo de novo e draw para redesenhar.
+func watchLoop(feed *changeFeed, refresh func() error, draw func()) {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	footerStyle := lipgloss.NewStyle().Foreground(mutedColor)
+	errorStyle := lipgloss.NewStyle().Foreground(errorColor)
+
+	updated := time.Now()
+	redraw := true
+	// pending fica ligado enquanto uma mudança detectada não foi carregada: o
+	// feed já a marcou como vista, então um refresh que falha é repetido a
+	// cada ciclo até dar certo
+	pending := false
+	var lastErr error
+	for {
+		if redraw {
+			fmt.Print("\033[H\033[2J")
+			draw()
+			fmt.Println()
+		}
+
+		footer := footerStyle.Render(fmt.Sprintf("Atualizado às %s · a cada %s · Ctrl+C para sair", updated.Format("15:04:05"), watchInterval))
+		if lastErr != nil {
+			footer += "  " + errorStyle.Render("falha ao atualizar: "+lastErr.Error())
+		}
+		fmt.Print("\r\033[K" + footer)
+
+		select {
+		case <-ctx.Done():
+			fmt.Println()
+			return
+		case <-time.After(watchInterval):
+		}
+
+		checked := time.Now()
+		changed, err := feed.changed()
+		pending = pending || changed
+		redraw = false
+		if err == nil && pending {
+			err = refresh()
+			if err == nil {
+				pending = false
+				redraw = true
+			}
+		}
+		lastErr = err
+		if err == nil {
+			updated = checked
+		}
+	}
+}
+
+// changeFeed detecta work packages alterados usando o filtro updatedAt, sem
+// baixar a listagem inteira a cada ciclo do --watch.
+type changeFeed struct {
+	client  *openproject.Client
+	filters []openproject.Filter
+	since   time.Time
+	seen    map[int]string
+}
+
+// newChangeFeed acompanha os work packages do projeto que atendem a filters
+// (nenhum filtro: todos, de qualquer status).
+func newChangeFeed(client *openproject.Client, filters ...openproject.Filter) *changeFeed {
+	return &changeFeed{client: client, filters: filters, since: time.Now(), seen: map[int]string{}}
+}
+
+// prime registra a versão já exibida dos work packages, para que não contem
+// como mudança na primeira consulta.
+func (f *changeFeed) prime(wps ...openproject.WorkPackage) {
+	for _, wp := range wps {
+		f.seen[wp.ID] = wp.UpdatedAt
+	}
+}
+
+func (f *changeFeed) changed() (bool, error) {
+	now := time.Now()
+	// a margem cobre diferenças de relógio com o servidor; o que já foi visto
+	// na mesma versão é ignorado
+	filters := append([]openproject.Filter{openproject.AnyStatusFilter(), openproject.UpdatedSinceFilter(f.since.Add(-time.Minute))}, f.filters...)
+	wps, err := f.client.ListAllWorkPackages(filters...)
+	if err != nil {
+		return false, err
+	}
+	f.since = now
+
+	changed := false
+	for _, wp := range wps {
+		if f.seen[wp.ID] != wp.UpdatedAt {
+			f.seen[wp.ID] = wp.UpdatedAt
+			changed = true
+		}
+	}
+	return changed, nil
+}
+
+// changeTracker lembra status e assignee de cada work package exibido para
+// destacar, no redesenho seguinte, o que mudou. Um tracker nil não destaca
+// nada, então as listagens funcionam igual sem --watch.
+type changeTracker struct {
+	prev    map[int]trackedState
+	current map[int]trackedState
+}
+
+type trackedState struct {
+	subject  string
+	status   string
+	assignee string
+}
+
+var (
+	changedMarkStyle = lipgloss.NewStyle().Bold(true).Foreground(warningColor)
+	changedNoteStyle = lipgloss.NewStyle().Foreground(warningColor)
+)
+
+// newChangeTracker retorna nil quando --watch não foi usado.
+func newChangeTracker() *changeTracker {
+	if watchInterval == 0 {
+		return nil
+	}
+	return &changeTracker{}
+}
+
+// next começa um novo desenho: o que foi exibido no anterior vira a referência.
+func (t *changeTracker) next() {
+	if t == nil {
+		return
+	}
+	if t.current != nil {
+		t.prev = t.current
+	}
+	t.current = map[int]trackedState{}
+}
+
+// diff registra o work package e descreve o que mudou desde o desenho
+// anterior. isNew indica que ele não estava na tela.
+func (t *changeTracker) diff(wp openproject.WorkPackage) (changes []string, isNew bool) {
+	if t == nil {
+		return nil, false
+	}
+
+	state := trackedState{subject: wp.Subject, status: wp.Links.Status.Title, assignee: wp.Links.Assignee.Title}
+	t.current[wp.ID] = state
+	if t.prev == nil {
+		return nil, false
+	}
+
+	old, ok := t.prev[wp.ID]
+	if !ok {
+		return nil, true
+	}
+	if old.status != state.status {
+		changes = append(changes, old.status+" → "+state.status)
+	}
+	if old.assignee != state.assignee {
+		changes = append(changes, userOrNobody(old.assignee)+" → "+userOrNobody(state.assignee))
+	}
+	return changes, false
+}
+
+// row é a linha do work package nas listagens, marcada quando status ou
+// assignee mudaram (●) ou quando ele entrou na lista (+).
+func (t *changeTracker) row(wp openproject.WorkPackage) string {
+	if t == nil {
+		return workPackageRow(wp)
+	}
+
+	changes, isNew := t.diff(wp)
+	switch {
+	case isNew:
+		return changedMarkStyle.Render("+ ") + workPackageRow(wp) + "  " + changedNoteStyle.Render("novo")
+	case len(changes) > 0:
+		return changedMarkStyle.Render("● ") + workPackageRow(wp) + "  " + changedNoteStyle.Render(strings.Join(changes, ", "))
+	default:
+		return "  " + workPackageRow(wp)
+	}
+}
+
+// removed lista os work packages do desenho anterior que não foram exibidos
+// neste.
+func (t *changeTracker) removed() []string {
+	if t == nil || t.prev == nil {
+		return nil
+	}
+
+	var gone []string
+	for id, state := range t.prev {
+		if _, ok := t.current[id]; !ok {
+			gone = append(gone, fmt.Sprintf("#%d %s", id, state.subject))
+		}
+	}
+	sort.Strings(gone)
+	return gone
+}
+
+// printRemoved exibe os work packages que saíram da tela desde o último desenho.
+func (t *changeTracker) printRemoved() {
+	if gone := t.removed(); len(gone) > 0 {
+		fmt.Println()
+		fmt.Println(changedNoteStyle.Render("Saíram: " + strings.Join(gone, " · ")))
+	}
+}
+
+func userOrNobody(name string) string {
+	if name == "" {
+		return "ninguém"
+	}
+	return name
+}
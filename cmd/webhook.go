@@ -0,0 +1,243 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/guialveess/opencli/internal/webhook"
+	"github.com/spf13/cobra"
+)
+
+var (
+	webhookPort     int
+	webhookHost     string
+	webhookRecord   string
+	webhookURL      string
+	webhookInsecure bool
+)
+
+var webhookCmd = &cobra.Command{
+	Use:   "webhook",
+	Short: "Recebe webhooks do OpenProject e executa ações",
+	Long:  "Comandos para receber os webhooks do OpenProject e reagir a eles com comandos, notificações ou mensagens.",
+}
+
+var webhookServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "Inicia o servidor que recebe os webhooks",
+	Long: `Inicia um servidor HTTP que recebe os webhooks do OpenProject (Administração >
+API e webhooks), verifica a assinatura com webhook.secret e executa as ações
+de webhook.actions que casam com cada evento, na ordem de chegada.
+
+Cada ação pode rodar um comando (run), exibir uma notificação na área de
+trabalho (notify) e/ou escrever uma mensagem na saída padrão (message).
+notify e message são templates Go com {{.Action}}, {{.ID}}, {{.Subject}},
+{{.Status}}, {{.Type}}, {{.Priority}}, {{.Assignee}}, {{.Responsible}},
+{{.Version}}, {{.Project}}, {{.URL}} e {{.WorkPackage}}. Os comandos recebem
+os mesmos dados nas variáveis OP_ACTION, OP_WP_ID, OP_WP_SUBJECT,
+OP_WP_STATUS, OP_WP_TYPE, OP_WP_PRIORITY, OP_WP_ASSIGNEE, OP_WP_VERSION,
+OP_WP_PROJECT e OP_WP_URL, e o payload JSON na entrada padrão.
+
+Por padrão o servidor só escuta em 127.0.0.1; para receber do OpenProject em
+outra máquina use --host 0.0.0.0 (ou o IP da interface). Sem webhook.secret o
+servidor não inicia, a menos que --insecure seja usado, e mesmo assim só em
+um endereço local.
+
+Com --record, cada payload aceito é gravado para ser reenviado depois com
+"op webhook replay".
+
+Exemplos:
+  op webhook serve
+  op webhook serve --port 9000 --host 0.0.0.0
+  op webhook serve --record ./payloads`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		port := cfg.Webhook.Port
+		if cmd.Flags().Changed("port") {
+			port = webhookPort
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+		dispatcher, err := webhook.NewDispatcher(cfg.Webhook.Actions, client.WorkPackageURL, os.Stdout, webhookLogf)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro em webhook.actions: %v\n", err)
+			os.Exit(1)
+		}
+
+		// sem secret qualquer um que alcance a porta dispara as ações (inclusive run)
+		if cfg.Webhook.Secret == "" {
+			if !webhookInsecure {
+				fmt.Fprintln(os.Stderr, "Erro: webhook.secret não configurado (ou OPCLI_WEBHOOK_SECRET); use --insecure para aceitar requisições sem assinatura")
+				os.Exit(1)
+			}
+			if !isLoopback(webhookHost) {
+				fmt.Fprintf(os.Stderr, "Erro: sem webhook.secret o servidor só pode escutar em um endereço local, não em %q\n", webhookHost)
+				os.Exit(1)
+			}
+			ui.PrintInfo("webhook.secret não configurado: as requisições serão aceitas sem verificar a assinatura")
+		}
+		if len(cfg.Webhook.Actions) == 0 {
+			ui.PrintInfo("Nenhuma ação em webhook.actions: os eventos só serão registrados")
+		}
+
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+
+		go dispatcher.Run(ctx)
+
+		server := &http.Server{
+			Addr:              net.JoinHostPort(webhookHost, strconv.Itoa(port)),
+			Handler:           webhook.Handler(cfg.Webhook.Secret, webhookRecord, dispatcher.Enqueue, webhookLogf),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		go func() {
+			<-ctx.Done()
+			shutdown, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			server.Shutdown(shutdown)
+		}()
+
+		muted := lipgloss.NewStyle().Foreground(mutedColor)
+		fmt.Println(muted.Render(fmt.Sprintf("Recebendo webhooks em http://%s/ com %d ação(ões); Ctrl+C para sair.", server.Addr, len(cfg.Webhook.Actions))))
+
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fmt.Fprintf(os.Stderr, "Erro no servidor: %v\n", err)
+			os.Exit(1)
+		}
+	},
+}
+
+var webhookReplayCmd = &cobra.Command{
+	Use:   "replay <arquivo|diretório>...",
+	Short: "Reenvia payloads gravados para o servidor de webhooks",
+	Long: `Reenvia payloads gravados com "op webhook serve --record" (ou salvos à mão)
+para o servidor de webhooks, assinados com webhook.secret como o OpenProject
+faria. Diretórios são enviados em ordem de nome, que para os gravados é a ordem
+de chegada. Útil para testar as ações sem mexer nos work packages.
+
+Exemplos:
+  op webhook replay ./payloads
+  op webhook replay ./payloads/20261019-101500.123456-work_package_updated.json
+  op webhook replay ./payloads --url http://127.0.0.1:9000/`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		files, err := replayFiles(args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+		if len(files) == 0 {
+			fmt.Fprintln(os.Stderr, "Nenhum payload .json encontrado")
+			os.Exit(1)
+		}
+
+		url := webhookURL
+		if url == "" {
+			url = fmt.Sprintf("http://127.0.0.1:%d/", cfg.Webhook.Port)
+		}
+
+		failed := false
+		for _, file := range files {
+			body, err := os.ReadFile(file)
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("%s: %v", file, err))
+				failed = true
+				continue
+			}
+
+			status, err := webhook.Replay(url, cfg.Webhook.Secret, body)
+			switch {
+			case err != nil:
+				ui.PrintError(fmt.Sprintf("%s: %v", file, err))
+				failed = true
+			case status >= 300:
+				ui.PrintError(fmt.Sprintf("%s: %d %s", file, status, http.StatusText(status)))
+				failed = true
+			default:
+				ui.PrintSuccess(fmt.Sprintf("%s: %d %s", file, status, http.StatusText(status)))
+			}
+		}
+		if failed {
+			os.Exit(1)
+		}
+	},
+}
+
+// replayFiles expande os diretórios nos arquivos .json que contêm, em ordem
+// de nome; arquivos informados diretamente são mantidos na ordem dada.
+func replayFiles(args []string) ([]string, error) {
+	var files []string
+	for _, arg := range args {
+		info, err := os.Stat(arg)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, arg)
+			continue
+		}
+
+		matches, err := filepath.Glob(filepath.Join(arg, "*.json"))
+		if err != nil {
+			return nil, err
+		}
+		slices.Sort(matches)
+		files = append(files, matches...)
+	}
+	return files, nil
+}
+
+// isLoopback diz se host é um endereço local (127.0.0.1, ::1 ou localhost).
+// Vazio não é: o servidor escutaria em todas as interfaces.
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// webhookLogf registra na saída de erro, com horário, o que o servidor faz,
+// deixando a saída padrão só para as mensagens das ações.
+func webhookLogf(format string, args ...any) {
+	muted := lipgloss.NewStyle().Foreground(mutedColor)
+	fmt.Fprintf(os.Stderr, "%s %s\n", muted.Render(time.Now().Format("15:04:05")), fmt.Sprintf(format, args...))
+}
+
+func init() {
+	webhookServeCmd.Flags().IntVarP(&webhookPort, "port", "p", 8088, "Porta do servidor (padrão: webhook.port ou 8088)")
+	webhookServeCmd.Flags().StringVar(&webhookHost, "host", "127.0.0.1", "Endereço em que o servidor escuta (0.0.0.0 para todas as interfaces)")
+	webhookServeCmd.Flags().BoolVar(&webhookInsecure, "insecure", false, "Aceita requisições sem assinatura quando webhook.secret não está configurado (só em endereço local)")
+	webhookServeCmd.Flags().StringVar(&webhookRecord, "record", "", "Diretório onde gravar os payloads recebidos")
+	webhookReplayCmd.Flags().StringVar(&webhookURL, "url", "", "Endereço do servidor (padrão: http://127.0.0.1:<webhook.port>/)")
+
+	webhookCmd.AddCommand(webhookServeCmd)
+	webhookCmd.AddCommand(webhookReplayCmd)
+	rootCmd.AddCommand(webhookCmd)
+}
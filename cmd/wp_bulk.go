@@ -0,0 +1,381 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	bulkFilters     []string
+	bulkSets        []string
+	bulkDryRun      bool
+	bulkConcurrency int
+)
+
+var wpBulkCmd = &cobra.Command{
+	Use:   "bulk",
+	Short: "Operações em lote em Work Packages",
+	Long:  "Aplica operações a vários Work Packages de uma vez.",
+}
+
+var wpBulkUpdateCmd = &cobra.Command{
+	Use:   "update [id...]",
+	Short: "Atualiza vários Work Packages de uma vez",
+	Long: `Aplica as mesmas alterações (--set) a uma lista de IDs ou aos Work Packages
+que atendem aos filtros (--filter). Sem filtro de status, só os abertos entram.
+
+Campos do --set: subject, status, type, priority, version, assignee, responsible.
+Filtros: status (nome, open, closed, all), type, priority, version, assignee,
+responsible, author e subject (contém).
+
+Exemplos:
+  op wp bulk update 12 34 56 --set status=Done
+  op wp bulk update --filter version="Sprint 42" --set status=Done --dry-run
+  op wp bulk update --filter assignee=none --filter type=Bug --set assignee=me`,
+	Run: func(cmd *cobra.Command, args []string) {
+		if len(args) > 0 && len(bulkFilters) > 0 {
+			fmt.Fprintln(os.Stderr, "Use IDs ou --filter, não os dois")
+			os.Exit(1)
+		}
+		if len(args) == 0 && len(bulkFilters) == 0 {
+			fmt.Fprintln(os.Stderr, "Informe os IDs ou ao menos um --filter")
+			os.Exit(1)
+		}
+
+		ids := make([]int, 0, len(args))
+		for _, arg := range args {
+			id, err := strconv.Atoi(arg)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "ID inválido: %s\n", arg)
+				os.Exit(1)
+			}
+			ids = append(ids, id)
+		}
+
+		fields, err := parseAssignments(bulkSets)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro em --set: %v\n", err)
+			os.Exit(1)
+		}
+		if len(fields) == 0 {
+			fmt.Fprintln(os.Stderr, "Nada para atualizar: informe ao menos um --set campo=valor")
+			os.Exit(1)
+		}
+
+		filterFields, err := parseAssignments(bulkFilters)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro em --filter: %v\n", err)
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+
+		ui.StartSpinner("Resolvendo campos...")
+		patch, labels, err := buildPatch(client, fields)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		ui.StartSpinner("Carregando Work Packages...")
+		var targets []bulkItem
+		if len(ids) > 0 {
+			targets = fetchBulkTargets(client, ids)
+		} else {
+			var filters []openproject.Filter
+			filters, err = buildFilters(client, filterFields)
+			if err == nil {
+				var wps []openproject.WorkPackage
+				wps, err = client.ListAllWorkPackages(filters...)
+				for i := range wps {
+					targets = append(targets, bulkItem{ID: wps[i].ID, WorkPackage: &wps[i]})
+				}
+			}
+		}
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar Work Packages: %v\n", err)
+			os.Exit(1)
+		}
+
+		if len(targets) == 0 {
+			ui.PrintInfo("Nenhum Work Package encontrado")
+			return
+		}
+
+		for i := range targets {
+			if targets[i].WorkPackage != nil {
+				targets[i].Changes = diffPatch(targets[i].WorkPackage, patch, labels)
+			}
+		}
+
+		pending := printBulkPlan(targets)
+
+		if bulkDryRun {
+			fmt.Println()
+			ui.PrintInfo(fmt.Sprintf("Simulação: %d Work Package(s) seriam alterados. Nada foi enviado.", pending))
+			return
+		}
+
+		if pending == 0 {
+			fmt.Println()
+			ui.PrintInfo("Nada para alterar")
+			if reportBulkFailures(targets) > 0 {
+				os.Exit(1)
+			}
+			return
+		}
+
+		if !autoConfirm {
+			response := ask(fmt.Sprintf("\nAplicar em %d Work Package(s)? ", pending), "[Y/n]: ")
+			if !isYes(response) {
+				fmt.Println("Operação cancelada.")
+				return
+			}
+		}
+
+		var done atomic.Int32
+		ui.StartSpinner(fmt.Sprintf("Atualizando 0/%d...", pending))
+		forEachConcurrently(len(targets), bulkConcurrency, func(i int) {
+			item := &targets[i]
+			if item.Err != nil || len(item.Changes) == 0 {
+				return
+			}
+
+			_, item.Err = updateWithRetry(client, item.ID, patchFor(patch, item.Changes))
+			item.Updated = item.Err == nil
+			ui.UpdateSpinner(fmt.Sprintf("Atualizando %d/%d...", done.Add(1), pending))
+		})
+		ui.StopSpinner()
+
+		if !printBulkReport(targets) {
+			os.Exit(1)
+		}
+	},
+}
+
+// bulkItem é um work package alvo da operação em lote e o resultado dela.
+type bulkItem struct {
+	ID          int
+	WorkPackage *openproject.WorkPackage
+	Changes     []fieldChange
+	Updated     bool
+	Err         error
+}
+
+type fieldChange struct {
+	Field string
+	From  string
+	To    string
+}
+
+// fetchBulkTargets carrega os work packages pelos IDs em paralelo. Falhas
+// ficam registradas no item e aparecem no relatório final.
+func fetchBulkTargets(client *openproject.Client, ids []int) []bulkItem {
+	items := make([]bulkItem, len(ids))
+	forEachConcurrently(len(ids), bulkConcurrency, func(i int) {
+		items[i].ID = ids[i]
+		items[i].WorkPackage, items[i].Err = client.GetWorkPackage(ids[i])
+	})
+	return items
+}
+
+// diffPatch compara os valores atuais do work package com os do patch e
+// retorna só os campos que de fato mudam, em ordem alfabética. Campos que são
+// links comparam o ID, não o nome exibido.
+func diffPatch(wp *openproject.WorkPackage, patch *openproject.WorkPackagePatch, labels map[string]string) []fieldChange {
+	var changes []fieldChange
+	for field, to := range labels {
+		from := currentFieldValue(wp, field)
+
+		if href, ok := patch.Links[field]; ok {
+			if currentFieldLink(wp, field).ID() == (openproject.Link{Href: href}).ID() {
+				continue
+			}
+		} else if from == to {
+			continue
+		}
+		changes = append(changes, fieldChange{Field: field, From: from, To: to})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+	return changes
+}
+
+func currentFieldLink(wp *openproject.WorkPackage, field string) openproject.Link {
+	switch field {
+	case "status":
+		return wp.Links.Status
+	case "type":
+		return wp.Links.Type
+	case "priority":
+		return wp.Links.Priority
+	case "version":
+		return wp.Links.Version
+	case "assignee":
+		return wp.Links.Assignee
+	case "responsible":
+		return wp.Links.Responsible
+	}
+	return openproject.Link{}
+}
+
+func currentFieldValue(wp *openproject.WorkPackage, field string) string {
+	switch field {
+	case "subject":
+		return wp.Subject
+	case "status":
+		return wp.Links.Status.Title
+	case "type":
+		return wp.Links.Type.Title
+	case "priority":
+		return wp.Links.Priority.Title
+	case "version":
+		return wp.Links.Version.Title
+	case "assignee":
+		return wp.Links.Assignee.Title
+	case "responsible":
+		return wp.Links.Responsible.Title
+	}
+	return ""
+}
+
+// patchFor restringe o patch aos campos que mudam neste work package.
+func patchFor(patch *openproject.WorkPackagePatch, changes []fieldChange) *openproject.WorkPackagePatch {
+	p := &openproject.WorkPackagePatch{Links: map[string]string{}}
+	for _, c := range changes {
+		if c.Field == "subject" {
+			p.Subject = patch.Subject
+			continue
+		}
+		p.Links[c.Field] = patch.Links[c.Field]
+	}
+	return p
+}
+
+// printBulkPlan exibe o diff planejado de cada work package e retorna
+// quantos serão alterados.
+func printBulkPlan(items []bulkItem) int {
+	fromStyle := lipgloss.NewStyle().Foreground(errorColor).Strikethrough(true)
+	toStyle := lipgloss.NewStyle().Foreground(successColor)
+	muted := lipgloss.NewStyle().Foreground(mutedColor)
+
+	pending := 0
+	for _, item := range items {
+		if item.Err != nil {
+			continue
+		}
+
+		id := idStyle.Render(fmt.Sprintf("#%-5d", item.ID))
+		if len(item.Changes) == 0 {
+			fmt.Printf("%s %s  %s\n", id, subjectStyle.Render(item.WorkPackage.Subject), muted.Render("(sem alterações)"))
+			continue
+		}
+
+		pending++
+		fmt.Printf("%s %s\n", id, subjectStyle.Render(item.WorkPackage.Subject))
+		for _, c := range item.Changes {
+			from, to := c.From, c.To
+			if from == "" {
+				from = "-"
+			}
+			if to == "" {
+				to = "-"
+			}
+			fmt.Printf("       %s %s → %s\n", muted.Render(c.Field+":"), fromStyle.Render(from), toStyle.Render(to))
+		}
+	}
+
+	return pending
+}
+
+// printBulkReport exibe o resultado por item e retorna false se algum falhou.
+func printBulkReport(items []bulkItem) bool {
+	fmt.Println()
+
+	updated := 0
+	for _, item := range items {
+		if item.Updated {
+			updated++
+			ui.PrintSuccess(fmt.Sprintf("#%d %s", item.ID, item.WorkPackage.Subject))
+		}
+	}
+
+	failed := reportBulkFailures(items)
+
+	fmt.Println()
+	summary := fmt.Sprintf("%d atualizado(s), %d falha(s), %d sem alterações", updated, failed, len(items)-updated-failed)
+	if failed > 0 {
+		ui.PrintError(summary)
+		return false
+	}
+	ui.PrintSuccess(summary)
+	return true
+}
+
+func reportBulkFailures(items []bulkItem) int {
+	failed := 0
+	for _, item := range items {
+		if item.Err != nil {
+			failed++
+			ui.PrintError(fmt.Sprintf("#%d %v", item.ID, item.Err))
+		}
+	}
+	return failed
+}
+
+// forEachConcurrently chama fn para cada índice de 0 a n-1 usando no máximo
+// workers goroutines ao mesmo tempo.
+func forEachConcurrently(n, workers int, fn func(i int)) {
+	if workers < 1 {
+		workers = 1
+	}
+
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+
+	for w := 0; w < min(workers, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				fn(i)
+			}
+		}()
+	}
+
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+}
+
+func init() {
+	wpBulkUpdateCmd.Flags().StringArrayVar(&bulkSets, "set", nil, "Campo a alterar, campo=valor (pode repetir)")
+	wpBulkUpdateCmd.Flags().StringArrayVar(&bulkFilters, "filter", nil, "Filtro campo=valor para escolher os Work Packages (pode repetir)")
+	wpBulkUpdateCmd.Flags().BoolVar(&bulkDryRun, "dry-run", false, "Mostra as alterações planejadas sem aplicá-las")
+	wpBulkUpdateCmd.Flags().IntVarP(&bulkConcurrency, "concurrency", "j", 4, "Quantidade de atualizações simultâneas")
+	wpBulkUpdateCmd.Flags().BoolVarP(&autoConfirm, "yes", "y", false, "Aplicar sem pedir confirmação")
+	wpBulkCmd.AddCommand(wpBulkUpdateCmd)
+	wpCmd.AddCommand(wpBulkCmd)
+}
@@ -1,135 +1,235 @@
 package cmd
 
 import (
-	"bufio"
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/ai"
 	"github.com/guialveess/opencli/internal/clipboard"
 	"github.com/guialveess/opencli/internal/config"
-	"github.com/guialveess/opencli/internal/ollama"
 	"github.com/guialveess/opencli/internal/openproject"
 	"github.com/guialveess/opencli/internal/ui"
 	"github.com/spf13/cobra"
 )
 
 var (
-	ollamaModel   string
 	autoConfirm   bool
 	fromClipboard bool
+	noStream      bool
+	promptName    string
+	promptLang    string
+	promptHint    string
+	noAttach      bool
+	maxImageSize  int
+	cropRegion    string
+	keepClipboard bool
+	createVersion string
 )
 
 var wpCreateFromImageCmd = &cobra.Command{
-	Use:   "create-from-image [image-path]",
+	Use:   "create-from-image [image-path...]",
 	Short: "Cria um Work Package a partir de uma imagem",
-	Long: `Analisa uma imagem (screenshot de bug, erro, etc.) usando IA local (Ollama)
+	Long: `Analisa uma imagem (screenshot de bug, erro, etc.) usando IA local
 e cria um Work Package com título e descrição gerados automaticamente.
 
-Requer Ollama rodando localmente com um modelo de visão (ex: llava, minicpm-v).
+Requer Ollama rodando localmente com um modelo de visão (ex: llava, minicpm-v)
+ou um servidor compatível com OpenAI (ai.provider: openai na configuração).
 
 Exemplos:
   op wp create-from-image ./screenshot-bug.png
   op wp create-from-image ./erro.jpg --model llava
   op wp create-from-image ./bug.png -y  # cria sem confirmação
-  op wp create-from-image --clipboard   # usa imagem do clipboard`,
-	Args: cobra.MaximumNArgs(1),
+  op wp create-from-image --clipboard   # usa imagem do clipboard
+  op wp create-from-image antes.png depois.png
+  op wp create-from-image ./passos/      # todas as imagens do diretório
+  op wp create-from-image "fluxo-*.png"`,
+	Args: cobra.ArbitraryArgs,
 	Run:  runCreateFromImage,
 }
 
 func runCreateFromImage(cmd *cobra.Command, args []string) {
-	var imagePath string
-	var cleanupPath string
+	var clip *clipboard.Image
+
+	imagePaths, err := collectImagePaths(args)
+	if err != nil {
+		ui.PrintError(err.Error())
+		exitCreate(1)
+	}
 
 	if fromClipboard {
 		ui.StartSpinner("Obtendo imagem do clipboard...")
-		path, err := clipboard.GetImageFromClipboard()
+		img, err := clipboard.GetImageFromClipboard()
 		ui.StopSpinner()
 
 		if err != nil {
 			ui.PrintError(err.Error())
-			os.Exit(1)
+			exitCreate(1)
 		}
-		imagePath = path
-		cleanupPath = path
+		imagePaths = append(imagePaths, img.Path)
+		clip = img
 		ui.PrintSuccess("Imagem obtida do clipboard")
-	} else {
-		if len(args) == 0 {
-			ui.PrintError("Forneça o caminho da imagem ou use --clipboard")
-			fmt.Println()
-			ui.PrintInfo("Uso: op wp create-from-image <image-path>")
-			ui.PrintInfo("     op wp create-from-image --clipboard")
-			os.Exit(1)
+	}
+
+	if len(imagePaths) == 0 {
+		ui.PrintError("Forneça o caminho da imagem ou use --clipboard")
+		fmt.Println()
+		ui.PrintInfo("Uso: op wp create-from-image <image-path>...")
+		ui.PrintInfo("     op wp create-from-image --clipboard")
+		exitCreate(1)
+	}
+
+	if len(imagePaths) > 1 {
+		ui.PrintInfo(fmt.Sprintf("%d imagens serão analisadas em sequência", len(imagePaths)))
+	}
+
+	defer clip.Cleanup()
+
+	cfg, err := config.Load()
+	if err != nil {
+		ui.PrintError(fmt.Sprintf("Erro ao carregar configuração: %v", err))
+		exitCreate(1)
+	}
+
+	if clip != nil && (keepClipboard || cfg.Clipboard.Keep) {
+		archived, err := clip.Archive(cfg.Clipboard.ArchiveDir)
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Erro ao arquivar imagem: %v", err))
+		} else {
+			ui.PrintInfo(fmt.Sprintf("Imagem arquivada em %s", archived))
 		}
+	}
+
+	provider, err := newAIProvider(cfg)
+	if err != nil {
+		ui.PrintError(err.Error())
+		exitCreate(1)
+	}
 
-		imagePath = args[0]
+	opClient := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
 
-		if _, err := os.Stat(imagePath); os.IsNotExist(err) {
-			ui.PrintError(fmt.Sprintf("Arquivo não encontrado: %s", imagePath))
-			os.Exit(1)
+	// a versão é resolvida antes da análise para não perder o trabalho da IA com um nome errado
+	var version *openproject.Version
+	if createVersion != "" {
+		version, err = opClient.FindVersion(createVersion)
+		if err != nil {
+			ui.PrintError(err.Error())
+			exitCreate(1)
 		}
 	}
 
-	if cleanupPath != "" {
-		defer clipboard.Cleanup(cleanupPath)
+	prompt, err := buildAnalysisPrompt(cfg, opClient, len(imagePaths))
+	if err != nil {
+		ui.PrintError(err.Error())
+		exitCreate(1)
 	}
 
-	cfg, err := config.Load()
+	imageOpts := ai.ImageOptions{MaxDimension: cfg.AI.MaxImageSize}
+	if cmd.Flags().Changed("max-size") {
+		imageOpts.MaxDimension = maxImageSize
+	}
+	if cropRegion != "" {
+		imageOpts.Crop, err = ai.ParseCrop(cropRegion)
+		if err != nil {
+			ui.PrintError(err.Error())
+			exitCreate(1)
+		}
+	}
+
+	// as imagens reduzidas vão para o modelo; os originais continuam sendo anexados
+	images, err := ai.PrepareImages(imagePaths, imageOpts)
 	if err != nil {
-		ui.PrintError(fmt.Sprintf("Erro ao carregar configuração: %v", err))
-		os.Exit(1)
+		ui.PrintError(err.Error())
+		exitCreate(1)
 	}
 
-	ollamaClient := ollama.NewClient(ollamaModel)
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	var onToken func(string)
+	draft := &ui.DraftPrinter{}
+	if !noStream {
+		draft.Filter, err = draftFilter(cfg)
+		if err != nil {
+			ui.PrintError(err.Error())
+			exitCreate(1)
+		}
+		onToken = draft.Write
+	}
 
 	fmt.Println()
 	ui.StartThinkingSpinner("IA analisando imagem...")
-	analysis, err := ollamaClient.AnalyzeScreenshot(imagePath)
+	analysis, err := ai.AnalyzeScreenshots(ctx, provider, images, prompt, onToken)
 	ui.StopSpinner()
+	draft.Done()
+	stop()
+
+	if errors.Is(err, context.Canceled) {
+		ui.PrintInfo("Análise cancelada")
+		exitCreate(130)
+	}
 
 	if err != nil {
 		ui.PrintError(fmt.Sprintf("Erro ao analisar imagem: %v", err))
-		fmt.Println()
-		ui.PrintInfo("Verifique se o Ollama está rodando: ollama serve")
-		ui.PrintInfo(fmt.Sprintf("E se o modelo está instalado: ollama pull %s", ollamaModel))
-		os.Exit(1)
+		printAIHints(cfg.AI)
+		exitCreate(1)
+	}
+
+	findings, err := redactTexts(cfg, &analysis.Title, &analysis.Description)
+	if err != nil {
+		ui.PrintError(err.Error())
+		exitCreate(1)
 	}
 
 	fmt.Println(ui.RenderAnalysisResult(analysis.Title, analysis.Description))
+	printRedactions(findings)
 
-	if !autoConfirm {
-		promptStyle := lipgloss.NewStyle().
-			Foreground(lipgloss.Color("#A78BFA")).
-			Bold(true)
+	if !skipDuplicates {
+		embedKey := ai.EmbedKey(cfg.AI, provider)
+		action := checkDuplicates(opClient, provider, embedKey, analysis.Title, analysis.Description, !autoConfirm)
 
-		fmt.Print(promptStyle.Render("\nCriar Work Package? "))
-		fmt.Print("[Y/n]: ")
+		if action.Cancel {
+			ui.PrintInfo("Operação cancelada")
+			return
+		}
 
-		reader := bufio.NewReader(os.Stdin)
-		response, _ := reader.ReadString('\n')
-		response = strings.TrimSpace(strings.ToLower(response))
+		if action.CommentOn != 0 {
+			commentOnDuplicate(opClient, action.CommentOn, analysis)
+			attachImages(opClient, action.CommentOn, imagePaths)
+			copyWorkPackageLink(opClient, action.CommentOn, action.Subject)
+			return
+		}
+	}
 
-		if response != "" && response != "y" && response != "yes" && response != "s" && response != "sim" {
+	if !autoConfirm {
+		if !isYes(ask("\nCriar Work Package? ", "[Y/n]: ")) {
 			ui.PrintInfo("Operação cancelada")
 			return
 		}
 	}
 
-	opClient := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
-
 	ui.StartSpinner("Criando Work Package...")
 	wp, err := opClient.CreateWorkPackage(&openproject.CreateWorkPackageRequest{
 		Subject:     analysis.Title,
 		Description: analysis.Description,
+		VersionID:   versionID(version),
 	})
 	ui.StopSpinner()
 
 	if err != nil {
 		ui.PrintError(fmt.Sprintf("Erro ao criar Work Package: %v", err))
-		os.Exit(1)
+		exitCreate(1)
 	}
 
+	attachImages(opClient, wp.ID, imagePaths)
+	addWatchersTo(opClient, wp.ID)
+
 	fmt.Println()
 	successBox := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
@@ -143,12 +243,162 @@ func runCreateFromImage(cmd *cobra.Command, args []string) {
 
 	msg := fmt.Sprintf("Work Package %s criado com sucesso!", idStyle.Render(fmt.Sprintf("#%d", wp.ID)))
 	fmt.Println(successBox.Render(msg))
+
+	copyWorkPackageLink(opClient, wp.ID, wp.Subject)
+
+	if openInBrowser {
+		openURL(opClient.WorkPackageURL(wp.ID))
+	}
+}
+
+var imageExtensions = map[string]bool{
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".webp": true, ".bmp": true,
+}
+
+// collectImagePaths expande os argumentos em uma lista ordenada de imagens:
+// diretórios viram as imagens que contêm e padrões glob são expandidos.
+func collectImagePaths(args []string) ([]string, error) {
+	var paths []string
+
+	for _, arg := range args {
+		if strings.ContainsAny(arg, "*?[") {
+			matches, err := filepath.Glob(arg)
+			if err != nil {
+				return nil, fmt.Errorf("padrão inválido: %s", arg)
+			}
+			if len(matches) == 0 {
+				return nil, fmt.Errorf("nenhum arquivo corresponde a %s", arg)
+			}
+			sort.Strings(matches)
+			paths = append(paths, matches...)
+			continue
+		}
+
+		info, err := os.Stat(arg)
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("arquivo não encontrado: %s", arg)
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if !info.IsDir() {
+			paths = append(paths, arg)
+			continue
+		}
+
+		entries, err := os.ReadDir(arg)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, entry := range entries {
+			if !entry.IsDir() && imageExtensions[strings.ToLower(filepath.Ext(entry.Name()))] {
+				paths = append(paths, filepath.Join(arg, entry.Name()))
+				found = true
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("nenhuma imagem encontrada em %s", arg)
+		}
+	}
+
+	return paths, nil
+}
+
+func attachImages(client *openproject.Client, id int, imagePaths []string) {
+	if noAttach {
+		return
+	}
+
+	for _, path := range imagePaths {
+		ui.StartSpinner(fmt.Sprintf("Anexando %s...", filepath.Base(path)))
+		_, err := client.AddAttachment(id, path)
+		ui.StopSpinner()
+
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Erro ao anexar imagem: %v", err))
+			continue
+		}
+		ui.PrintSuccess(fmt.Sprintf("Imagem anexada: %s", filepath.Base(path)))
+	}
+}
+
+// exitCreate encerra o comando removendo imagens temporárias do clipboard,
+// já que os.Exit não executa os defers.
+func exitCreate(code int) {
+	clipboard.CleanupAll()
+	os.Exit(code)
+}
+
+// buildAnalysisPrompt renderiza o template de prompt selecionado com --prompt/--lang.
+// Os tipos do projeto ficam disponíveis em .Types; se a busca falhar, seguimos sem eles.
+func buildAnalysisPrompt(cfg *config.Config, client *openproject.Client, imageCount int) (string, error) {
+	lang := cfg.AI.Language
+	if promptLang != "" {
+		lang = promptLang
+	}
+	if lang != "pt" && lang != "en" {
+		return "", fmt.Errorf("idioma inválido: %s (use pt ou en)", lang)
+	}
+
+	data := ai.PromptData{
+		Project:    cfg.Project,
+		Lang:       lang,
+		Hint:       promptHint,
+		ImageCount: imageCount,
+	}
+
+	if types, err := client.ListTypes(); err == nil {
+		for _, t := range types {
+			data.Types = append(data.Types, t.Name)
+		}
+	}
+
+	promptsDir := ""
+	if dir, err := config.Dir(); err == nil {
+		promptsDir = filepath.Join(dir, "prompts")
+	}
+
+	return ai.RenderPrompt(promptName, cfg.Prompts, promptsDir, data)
+}
+
+func commentOnDuplicate(client *openproject.Client, id int, analysis *ai.ImageAnalysis) {
+	comment := fmt.Sprintf("Ocorrência reportada novamente via `op wp create-from-image`:\n\n**%s**\n\n%s", analysis.Title, analysis.Description)
+
+	ui.StartSpinner(fmt.Sprintf("Comentando no Work Package #%d...", id))
+	err := client.AddComment(id, comment)
+	ui.StopSpinner()
+
+	if err != nil {
+		ui.PrintError(fmt.Sprintf("Erro ao comentar: %v", err))
+		exitCreate(1)
+	}
+
+	ui.PrintSuccess(fmt.Sprintf("Comentário adicionado ao Work Package #%d", id))
 }
 
 func init() {
-	wpCreateFromImageCmd.Flags().StringVarP(&ollamaModel, "model", "m", "llava", "Modelo Ollama para análise de imagem")
+	wpCreateFromImageCmd.Flags().StringVarP(&aiModel, "model", "m", "", "Modelo de visão para análise de imagem (padrão: ai.model ou llava)")
 	wpCreateFromImageCmd.Flags().BoolVarP(&autoConfirm, "yes", "y", false, "Criar sem pedir confirmação")
 	wpCreateFromImageCmd.Flags().BoolVarP(&fromClipboard, "clipboard", "c", false, "Usar imagem do clipboard")
+	wpCreateFromImageCmd.Flags().StringVar(&promptName, "prompt", "", "Template de prompt (seção prompts da config ou ~/.config/opcli/prompts/<nome>.tmpl)")
+	wpCreateFromImageCmd.Flags().StringVar(&promptLang, "lang", "", "Idioma da resposta da IA: pt ou en (padrão: ai.language)")
+	wpCreateFromImageCmd.Flags().StringVar(&promptHint, "hint", "", "Contexto extra para a IA (ex: \"erro ao finalizar pedido\")")
+	wpCreateFromImageCmd.Flags().IntVar(&maxImageSize, "max-size", 1344, "Maior lado, em pixels, da imagem enviada à IA (0 mantém o original; padrão: ai.max_image_size)")
+	wpCreateFromImageCmd.Flags().StringVar(&cropRegion, "crop", "", "Recorta a região x,y,w,h antes de enviar à IA")
+	wpCreateFromImageCmd.Flags().BoolVar(&noAttach, "no-attach", false, "Não anexar as imagens ao Work Package")
+	wpCreateFromImageCmd.Flags().BoolVar(&noStream, "no-stream", false, "Aguardar a resposta completa da IA em vez de exibi-la em tempo real")
+	wpCreateFromImageCmd.Flags().BoolVar(&noRedact, "no-redact", false, "Não mascarar segredos e dados pessoais no texto gerado")
+	wpCreateFromImageCmd.Flags().StringVar(&createVersion, "version", "", "Versão/sprint do Work Package criado (nome ou ID)")
+	wpCreateFromImageCmd.Flags().BoolVar(&keepClipboard, "keep", false, "Guardar a imagem do clipboard em clipboard.archive_dir")
+	wpCreateFromImageCmd.Flags().BoolVar(&skipDuplicates, "skip-duplicates", false, "Não procurar Work Packages duplicados")
+	wpCreateFromImageCmd.Flags().StringVar(&embedModel, "embed-model", "", "Modelo de embeddings na detecção de duplicados (padrão: ai.embed_model ou nomic-embed-text)")
+
+	addCopyFlag(wpCreateFromImageCmd)
+	addOpenFlag(wpCreateFromImageCmd)
+	addWatcherFlag(wpCreateFromImageCmd)
 
 	wpCmd.AddCommand(wpCreateFromImageCmd)
 }
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/report"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	exportFormat      string
+	exportFilters     []string
+	exportGroupBy     string
+	exportOutput      string
+	exportTemplate    string
+	exportTitle       string
+	exportOffline     bool
+	exportNoRelations bool
+)
+
+var wpExportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Exporta Work Packages para Markdown, CSV ou HTML",
+	Long: `Gera um relatório dos Work Packages que atendem aos filtros, agrupado em
+tabelas, com descrições, relações e tempo estimado/gasto.
+
+Os filtros são os mesmos do "op wp bulk update". O resultado de cada consulta
+fica em cache e pode ser reutilizado sem conexão com --offline.
+
+Exemplos:
+  op wp export --format md > relatorio.md
+  op wp export -o semana.html --filter version="Sprint 42" --group-by assignee
+  op wp export --format csv --filter status=all -o backlog.csv
+  op wp export --template cliente.md.tmpl --offline`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		format := exportFormat
+		if !cmd.Flags().Changed("format") && exportOutput != "" {
+			if ext := strings.TrimPrefix(strings.ToLower(filepath.Ext(exportOutput)), "."); slices.Contains(report.Formats, ext) {
+				format = ext
+			}
+		}
+		if exportTemplate == "" && !slices.Contains(report.Formats, format) {
+			fmt.Fprintf(os.Stderr, "Formato inválido: %s (use %s)\n", format, strings.Join(report.Formats, ", "))
+			os.Exit(1)
+		}
+		if !slices.Contains(report.GroupFields, exportGroupBy) {
+			fmt.Fprintf(os.Stderr, "--group-by inválido: %s (use %s)\n", exportGroupBy, strings.Join(report.GroupFields, ", "))
+			os.Exit(1)
+		}
+
+		filterFields, err := parseAssignments(exportFilters)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro em --filter: %v\n", err)
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+
+		ui.StartSpinner("Carregando Work Packages...")
+		snap, err := fetchWorkPackages(client, filterFields, !exportNoRelations, exportOffline)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao listar Work Packages: %v\n", err)
+			os.Exit(1)
+		}
+
+		rep := report.Build(snap.WorkPackages, snap.Relations, report.Options{
+			Title:   exportTitle,
+			Project: cfg.Project,
+			GroupBy: exportGroupBy,
+			URL:     client.WorkPackageURL,
+		})
+
+		var out io.Writer = os.Stdout
+		var file *os.File
+		if exportOutput != "" && exportOutput != "-" {
+			file, err = os.Create(exportOutput)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Erro ao criar %s: %v\n", exportOutput, err)
+				os.Exit(1)
+			}
+			out = file
+		}
+
+		w := bufio.NewWriter(out)
+		if exportTemplate != "" {
+			err = report.RenderTemplate(w, exportTemplate, rep)
+		} else {
+			err = report.Render(w, format, rep)
+		}
+		if err == nil {
+			err = w.Flush()
+		}
+		if file != nil {
+			if closeErr := file.Close(); err == nil {
+				err = closeErr
+			}
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao gerar relatório: %v\n", err)
+			os.Exit(1)
+		}
+
+		if file != nil {
+			ui.PrintSuccess(fmt.Sprintf("%d Work Package(s) exportados para %s", rep.Total, exportOutput))
+		}
+	},
+}
+
+func init() {
+	wpExportCmd.Flags().StringVarP(&exportFormat, "format", "f", "md", "Formato: md, csv ou html (padrão: extensão de --output ou md)")
+	wpExportCmd.Flags().StringArrayVar(&exportFilters, "filter", nil, "Filtro campo=valor, como no bulk update (pode repetir)")
+	wpExportCmd.Flags().StringVar(&exportGroupBy, "group-by", "status", "Agrupar por status, type, assignee, version, priority ou none")
+	wpExportCmd.Flags().StringVarP(&exportOutput, "output", "o", "", "Arquivo de saída (padrão: saída padrão)")
+	wpExportCmd.Flags().StringVar(&exportTemplate, "template", "", "Template Go próprio (.html usa html/template)")
+	wpExportCmd.Flags().StringVar(&exportTitle, "title", "Relatório de Work Packages", "Título do relatório")
+	wpExportCmd.Flags().BoolVar(&exportOffline, "offline", false, "Usa a última cópia em cache da consulta, sem acessar o servidor")
+	wpExportCmd.Flags().BoolVar(&exportNoRelations, "no-relations", false, "Não buscar as relações entre Work Packages")
+	wpCmd.AddCommand(wpExportCmd)
+}
@@ -0,0 +1,581 @@
+package cmd
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/cache"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/importer"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	importMap     string
+	importDryRun  bool
+	importRestart bool
+)
+
+var wpImportCmd = &cobra.Command{
+	Use:   "import <arquivo.csv|arquivo.json>",
+	Short: "Cria Work Packages a partir de um CSV ou JSON",
+	Long: `Cria um Work Package por linha de um arquivo CSV (com cabeçalho) ou JSON
+(lista de objetos).
+
+As colunas são associadas aos campos pelo nome do cabeçalho (ex: Título,
+Descrição, Tipo, Prioridade, Assignee, Pai, Versão) ou explicitamente com --map.
+Colunas com o nome de um campo customizado do projeto (ex: Cliente) ou
+customFieldN preenchem esse campo.
+
+Todas as linhas são validadas no OpenProject antes de qualquer criação. Se a
+importação for interrompida, rodar o mesmo comando continua de onde parou,
+sem duplicar as linhas já criadas.
+
+Exemplos:
+  op wp import backlog.csv --dry-run
+  op wp import backlog.csv --map "Resumo=subject,Detalhes=description,Cliente=customField3"
+  op wp import itens.json -y`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		path := args[0]
+
+		columns, rows, err := importer.ReadFile(path)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao ler %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		if len(rows) == 0 {
+			ui.PrintInfo("Nenhuma linha para importar")
+			return
+		}
+
+		var mapping map[string]string
+		if importMap != "" {
+			mapping, err = importer.ParseMapping(importMap)
+			if err == nil {
+				err = checkMappedColumns(mapping, columns)
+			}
+		} else {
+			mapping = importer.DetectMapping(columns)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro em --map: %v\n", err)
+			os.Exit(1)
+		}
+		if !mapsField(mapping, "subject") {
+			fmt.Fprintln(os.Stderr, "Nenhuma coluna corresponde ao título (subject). Use --map \"Coluna=subject\"")
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+
+		absPath, err := filepath.Abs(path)
+		if err != nil {
+			absPath = path
+		}
+		// o progresso é guardado por arquivo, para sobreviver a correções no conteúdo
+		pathSum := sha256.Sum256([]byte(absPath))
+		stateName := fmt.Sprintf("import-%s-%x.json", cfg.Project, pathSum[:8])
+
+		state := importState{File: absPath, Created: map[string]int{}}
+		if !importRestart {
+			if err := cache.Load(stateName, &state); err == nil && len(state.Created) > 0 {
+				ui.PrintInfo(fmt.Sprintf("Retomando importação: %d de %d linha(s) já criadas", len(state.Created), len(rows)))
+			}
+		}
+		if state.Created == nil {
+			state.Created = map[string]int{}
+		}
+
+		// linhas idênticas recebem chaves distintas pela ordem de ocorrência
+		keys := make([]string, len(rows))
+		seen := map[string]int{}
+		for i, row := range rows {
+			key := row.Key()
+			seen[key]++
+			keys[i] = fmt.Sprintf("%s-%d", key, seen[key])
+		}
+
+		resolver := &importResolver{client: client}
+
+		ui.StartSpinner("Carregando campos do projeto...")
+		err = resolver.load(mapping)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		if importMap == "" {
+			for _, column := range resolver.dropUnknownColumns(mapping) {
+				ui.PrintInfo(fmt.Sprintf("Coluna ignorada: %s", column))
+			}
+		}
+
+		items := make([]importItem, len(rows))
+		var validated int
+		var mu sync.Mutex
+
+		ui.StartSpinner(fmt.Sprintf("Validando 0/%d linha(s)...", len(rows)))
+		forEachConcurrently(len(rows), 4, func(i int) {
+			items[i].Row = rows[i]
+			if id, ok := state.Created[keys[i]]; ok {
+				items[i].CreatedID = id
+				return
+			}
+
+			items[i].Request, items[i].Errors = resolver.buildRequest(rows[i], mapping)
+			if len(items[i].Errors) == 0 {
+				items[i].Errors = resolver.validate(items[i].Request)
+			}
+
+			mu.Lock()
+			validated++
+			ui.UpdateSpinner(fmt.Sprintf("Validando %d/%d linha(s)...", validated, len(rows)))
+			mu.Unlock()
+		})
+		ui.StopSpinner()
+
+		pending, invalid := printImportPlan(items)
+
+		if invalid > 0 {
+			fmt.Println()
+			ui.PrintError(fmt.Sprintf("%d linha(s) com erro. Corrija o arquivo; nada foi criado.", invalid))
+			os.Exit(1)
+		}
+
+		if importDryRun {
+			fmt.Println()
+			ui.PrintInfo(fmt.Sprintf("Simulação: %d Work Package(s) seriam criados. Nada foi enviado.", pending))
+			return
+		}
+
+		if pending == 0 {
+			fmt.Println()
+			ui.PrintInfo("Todas as linhas já foram importadas")
+			_ = cache.Remove(stateName)
+			return
+		}
+
+		if !autoConfirm {
+			response := ask(fmt.Sprintf("\nCriar %d Work Package(s)? ", pending), "[Y/n]: ")
+			if !isYes(response) {
+				fmt.Println("Operação cancelada.")
+				return
+			}
+		}
+
+		fmt.Println()
+		created := 0
+		for i := range items {
+			item := &items[i]
+			if item.CreatedID != 0 {
+				continue
+			}
+
+			ui.StartSpinner(fmt.Sprintf("Criando %d/%d...", created+1, pending))
+			wp, err := client.CreateWorkPackage(item.Request)
+			ui.StopSpinner()
+			if err != nil {
+				ui.PrintError(fmt.Sprintf("%s: %v", item.Row.Pos, err))
+				fmt.Println()
+				ui.PrintInfo(fmt.Sprintf("%d criado(s) nesta execução. Rode o mesmo comando para continuar de onde parou.", created))
+				os.Exit(1)
+			}
+
+			created++
+			state.Created[keys[i]] = wp.ID
+			if err := cache.Save(stateName, &state); err != nil {
+				ui.PrintInfo(fmt.Sprintf("Não foi possível salvar o progresso: %v", err))
+			}
+			ui.PrintSuccess(fmt.Sprintf("#%d %s", wp.ID, wp.Subject))
+		}
+
+		_ = cache.Remove(stateName)
+
+		fmt.Println()
+		ui.PrintSuccess(fmt.Sprintf("%d Work Package(s) criados a partir de %s", created, filepath.Base(path)))
+	},
+}
+
+// importState guarda, pela chave de cada linha, o ID do work package já criado.
+type importState struct {
+	File    string         `json:"file"`
+	Created map[string]int `json:"created"`
+}
+
+type importItem struct {
+	Row       importer.Row
+	Request   *openproject.CreateWorkPackageRequest
+	Errors    []string
+	CreatedID int
+}
+
+func checkMappedColumns(mapping map[string]string, columns []string) error {
+	exists := make(map[string]bool, len(columns))
+	for _, c := range columns {
+		exists[c] = true
+	}
+
+	for column := range mapping {
+		if !exists[column] {
+			return fmt.Errorf("coluna %q não existe no arquivo (colunas: %s)", column, strings.Join(columns, ", "))
+		}
+	}
+	return nil
+}
+
+func mapsField(mapping map[string]string, field string) bool {
+	for _, f := range mapping {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+// importResolver converte os valores das linhas em IDs e hrefs da API,
+// guardando as listas e schemas já carregados. É seguro para uso concorrente.
+type importResolver struct {
+	client     *openproject.Client
+	types      []openproject.Type
+	priorities []openproject.Priority
+	versions   []openproject.Version
+
+	mu      sync.Mutex
+	users   map[string]*openproject.User
+	schemas map[int]map[string]openproject.SchemaField
+}
+
+// load busca de uma vez as listas usadas pelas colunas mapeadas e o schema do
+// tipo padrão.
+func (r *importResolver) load(mapping map[string]string) error {
+	var err error
+	if mapsField(mapping, "type") {
+		if r.types, err = r.client.ListTypes(); err != nil {
+			return err
+		}
+	}
+	if mapsField(mapping, "priority") {
+		if r.priorities, err = r.client.ListPriorities(); err != nil {
+			return err
+		}
+	}
+	if mapsField(mapping, "version") {
+		if r.versions, err = r.client.ListVersions(); err != nil {
+			return err
+		}
+	}
+
+	_, err = r.schema(0)
+	return err
+}
+
+// dropUnknownColumns remove do mapeamento automático as colunas que não são
+// campos padrão nem campos customizados do tipo padrão, e as retorna.
+func (r *importResolver) dropUnknownColumns(mapping map[string]string) []string {
+	schema, _ := r.schema(0)
+
+	var dropped []string
+	for column, field := range mapping {
+		if importer.IsStandard(field) {
+			continue
+		}
+		if _, ok := customFieldKey(schema, field); !ok {
+			delete(mapping, column)
+			dropped = append(dropped, column)
+		}
+	}
+
+	sort.Strings(dropped)
+	return dropped
+}
+
+// schema retorna os campos aplicáveis ao tipo (0 = tipo padrão do projeto).
+func (r *importResolver) schema(typeID int) (map[string]openproject.SchemaField, error) {
+	r.mu.Lock()
+	if s, ok := r.schemas[typeID]; ok {
+		r.mu.Unlock()
+		return s, nil
+	}
+	r.mu.Unlock()
+
+	form, err := r.client.ValidateWorkPackage(&openproject.CreateWorkPackageRequest{TypeID: typeID})
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.schemas == nil {
+		r.schemas = map[int]map[string]openproject.SchemaField{}
+	}
+	r.schemas[typeID] = form.Schema
+	return form.Schema, nil
+}
+
+func (r *importResolver) user(query string) (*openproject.User, error) {
+	key := strings.ToLower(query)
+
+	r.mu.Lock()
+	if u, ok := r.users[key]; ok {
+		r.mu.Unlock()
+		return u, nil
+	}
+	r.mu.Unlock()
+
+	u, err := r.client.FindUser(query)
+	if err != nil {
+		return nil, err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.users == nil {
+		r.users = map[string]*openproject.User{}
+	}
+	r.users[key] = u
+	return u, nil
+}
+
+// buildRequest monta o work package de uma linha. Os erros de resolução
+// (tipo inexistente, usuário ambíguo, valor inválido...) são retornados todos
+// juntos para serem exibidos de uma vez.
+func (r *importResolver) buildRequest(row importer.Row, mapping map[string]string) (*openproject.CreateWorkPackageRequest, []string) {
+	req := &openproject.CreateWorkPackageRequest{}
+	var errs []string
+	custom := map[string]string{}
+
+	columns := make([]string, 0, len(mapping))
+	for column := range mapping {
+		columns = append(columns, column)
+	}
+	sort.Strings(columns)
+
+	for _, column := range columns {
+		field, value := mapping[column], row.Values[column]
+		if value == "" {
+			continue
+		}
+
+		switch field {
+		case "subject":
+			req.Subject = value
+		case "description":
+			req.Description = value
+		case "type":
+			id, err := findByName(value, r.types, func(t openproject.Type) (int, string) { return t.ID, t.Name })
+			if err != nil {
+				errs = append(errs, "tipo: "+err.Error())
+			}
+			req.TypeID = id
+		case "priority":
+			id, err := findByName(value, r.priorities, func(p openproject.Priority) (int, string) { return p.ID, p.Name })
+			if err != nil {
+				errs = append(errs, "prioridade: "+err.Error())
+			}
+			req.PriorityID = id
+		case "version":
+			id, err := findByName(value, r.versions, func(v openproject.Version) (int, string) { return v.ID, v.Name })
+			if err != nil {
+				errs = append(errs, "versão: "+err.Error())
+			}
+			req.VersionID = id
+		case "assignee":
+			u, err := r.user(value)
+			if err != nil {
+				errs = append(errs, "assignee: "+err.Error())
+				continue
+			}
+			req.AssigneeID = u.ID
+		case "parent":
+			id, err := strconv.Atoi(strings.TrimPrefix(value, "#"))
+			if err != nil {
+				errs = append(errs, fmt.Sprintf("pai: ID inválido %q", value))
+			}
+			req.ParentID = id
+		default:
+			custom[field] = value
+		}
+	}
+
+	if req.Subject == "" {
+		errs = append(errs, "título vazio")
+	}
+
+	if len(custom) > 0 {
+		schema, err := r.schema(req.TypeID)
+		if err != nil {
+			return req, append(errs, err.Error())
+		}
+		for field, value := range custom {
+			if err := r.setCustomField(req, schema, field, value); err != nil {
+				errs = append(errs, err.Error())
+			}
+		}
+	}
+
+	sort.Strings(errs)
+	return req, errs
+}
+
+// setCustomField converte value para o tipo do campo no schema e o grava no
+// request como atributo ou, para listas e usuários, como link.
+func (r *importResolver) setCustomField(req *openproject.CreateWorkPackageRequest, schema map[string]openproject.SchemaField, field, value string) error {
+	key, ok := customFieldKey(schema, field)
+	if !ok {
+		return fmt.Errorf("campo %q não existe para este tipo", field)
+	}
+	def := schema[key]
+
+	if req.CustomFields == nil {
+		req.CustomFields = map[string]interface{}{}
+		req.CustomLinks = map[string]string{}
+	}
+
+	switch def.Type {
+	case "Integer":
+		n, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("%s: número inteiro inválido %q", def.Name, value)
+		}
+		req.CustomFields[key] = n
+	case "Float":
+		f, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+		if err != nil {
+			return fmt.Errorf("%s: número inválido %q", def.Name, value)
+		}
+		req.CustomFields[key] = f
+	case "Boolean":
+		switch importer.Normalize(value) {
+		case "true", "sim", "yes", "s", "y", "1", "x":
+			req.CustomFields[key] = true
+		case "false", "nao", "no", "n", "0":
+			req.CustomFields[key] = false
+		default:
+			return fmt.Errorf("%s: valor booleano inválido %q", def.Name, value)
+		}
+	case "Formattable":
+		req.CustomFields[key] = map[string]string{"format": "markdown", "raw": value}
+	case "CustomOption":
+		for _, option := range def.Links.AllowedValues {
+			if strings.EqualFold(option.Title, value) {
+				req.CustomLinks[key] = option.Href
+				return nil
+			}
+		}
+		titles := make([]string, 0, len(def.Links.AllowedValues))
+		for _, option := range def.Links.AllowedValues {
+			titles = append(titles, option.Title)
+		}
+		return fmt.Errorf("%s: opção %q não existe (disponíveis: %s)", def.Name, value, strings.Join(titles, ", "))
+	case "User":
+		u, err := r.user(value)
+		if err != nil {
+			return fmt.Errorf("%s: %v", def.Name, err)
+		}
+		req.CustomLinks[key] = fmt.Sprintf("/api/v3/users/%d", u.ID)
+	default:
+		req.CustomFields[key] = value
+	}
+
+	return nil
+}
+
+// validate envia o request ao formulário da API e retorna os erros por campo.
+func (r *importResolver) validate(req *openproject.CreateWorkPackageRequest) []string {
+	form, err := r.client.ValidateWorkPackage(req)
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	errs := make([]string, 0, len(form.Errors))
+	for field, message := range form.Errors {
+		name := field
+		if def, ok := form.Schema[field]; ok && def.Name != "" {
+			name = def.Name
+		}
+		errs = append(errs, fmt.Sprintf("%s: %s", name, message))
+	}
+	sort.Strings(errs)
+	return errs
+}
+
+// customFieldKey encontra o customFieldN pelo próprio nome da chave ou pelo
+// nome do campo no OpenProject.
+func customFieldKey(schema map[string]openproject.SchemaField, field string) (string, bool) {
+	if _, ok := schema[field]; ok && strings.HasPrefix(field, "customField") {
+		return field, true
+	}
+
+	for key, def := range schema {
+		if strings.HasPrefix(key, "customField") && importer.Normalize(def.Name) == importer.Normalize(field) {
+			return key, true
+		}
+	}
+	return "", false
+}
+
+func findByName[T any](name string, items []T, fields func(T) (int, string)) (int, error) {
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		id, n := fields(item)
+		if strings.EqualFold(n, name) || strconv.Itoa(id) == name {
+			return id, nil
+		}
+		names = append(names, n)
+	}
+	return 0, fmt.Errorf("%q não existe (disponíveis: %s)", name, strings.Join(names, ", "))
+}
+
+// printImportPlan lista as linhas com o resultado da validação e retorna
+// quantas serão criadas e quantas têm erro.
+func printImportPlan(items []importItem) (pending, invalid int) {
+	posStyle := lipgloss.NewStyle().Foreground(mutedColor).Width(10)
+	okStyle := lipgloss.NewStyle().Foreground(successColor)
+	errStyle := lipgloss.NewStyle().Foreground(errorColor)
+	muted := lipgloss.NewStyle().Foreground(mutedColor)
+
+	for _, item := range items {
+		pos := posStyle.Render(item.Row.Pos)
+
+		switch {
+		case item.CreatedID != 0:
+			fmt.Printf("%s %s %s\n", pos, muted.Render("↷"), muted.Render(fmt.Sprintf("já importada como #%d", item.CreatedID)))
+		case len(item.Errors) > 0:
+			invalid++
+			fmt.Printf("%s %s %s\n", pos, errStyle.Render("✗"), subjectStyle.Render(item.Request.Subject))
+			for _, e := range item.Errors {
+				fmt.Printf("%s   %s\n", posStyle.Render(""), errStyle.Render(e))
+			}
+		default:
+			pending++
+			fmt.Printf("%s %s %s\n", pos, okStyle.Render("✓"), subjectStyle.Render(item.Request.Subject))
+		}
+	}
+
+	return pending, invalid
+}
+
+func init() {
+	wpImportCmd.Flags().StringVar(&importMap, "map", "", "Mapeamento de colunas: \"Coluna=campo,Outra=campo\"")
+	wpImportCmd.Flags().BoolVar(&importDryRun, "dry-run", false, "Valida e mostra o que seria criado, sem criar")
+	wpImportCmd.Flags().BoolVar(&importRestart, "restart", false, "Ignora o progresso salvo e importa o arquivo do início")
+	wpImportCmd.Flags().BoolVarP(&autoConfirm, "yes", "y", false, "Criar sem pedir confirmação")
+	wpCmd.AddCommand(wpImportCmd)
+}
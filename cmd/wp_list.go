@@ -2,7 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"maps"
 	"os"
+	"slices"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
 	"github.com/guialveess/opencli/internal/config"
@@ -15,6 +18,9 @@ var (
 	listPage     int
 	listPageSize int
 	listAll      bool
+	listVersion  string
+	listFilters  []string
+	listPreset   string
 
 	assigneeStyle = lipgloss.NewStyle().
 			Foreground(lipgloss.Color("#60A5FA")).
@@ -32,10 +38,37 @@ func renderAssignee(name string) string {
 	return assigneeStyle.Render(name)
 }
 
+// workPackageRow é a linha de um work package nas listagens: ID, status,
+// assignee e título.
+func workPackageRow(wp openproject.WorkPackage) string {
+	return workPackageRowWith(wp, subjectStyle.Render(wp.Subject))
+}
+
+// workPackageRowWith é como workPackageRow, com o título já renderizado (ex:
+// com os termos da busca destacados).
+func workPackageRowWith(wp openproject.WorkPackage, subject string) string {
+	id := idStyle.Render(fmt.Sprintf("#%-5d", wp.ID))
+	status := statusStyle(wp.Links.Status.Title).Render(fmt.Sprintf("%-12s", wp.Links.Status.Title))
+	assignee := renderAssignee(wp.Links.Assignee.Title)
+
+	return fmt.Sprintf("%s  %s  %s  %s", id, status, assignee, subject)
+}
+
 var wpListCmd = &cobra.Command{
 	Use:   "list",
 	Short: "Lista os Work Packages do projeto",
-	Long:  "Lista todos os Work Packages do projeto configurado no OpenProject.",
+	Long: `Lista os Work Packages do projeto configurado no OpenProject.
+
+--filter aceita os mesmos campos do "op wp bulk update" e --preset usa um
+conjunto de filtros salvo na seção presets da configuração; os dois podem
+ser combinados, com --filter prevalecendo sobre o preset.
+
+Exemplos:
+  op wp list
+  op wp list --filter assignee=me --filter status="In Progress"
+  op wp list --preset review
+  op wp list --filter status=Homolog --watch`,
+	Args: cobra.NoArgs,
 	Run: func(cmd *cobra.Command, args []string) {
 		cfg, err := config.Load()
 		if err != nil {
@@ -43,68 +76,148 @@ var wpListCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		if err := checkWatchInterval(); err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
 		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
 
-		header := lipgloss.NewStyle().
-			Bold(true).
-			Foreground(primaryColor).
-			MarginBottom(1)
+		fields, err := listFilterFields(cfg)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
 
-		if listAll {
-			ui.StartSpinner("Carregando Work Packages...")
-			workPackages, err := client.ListAllWorkPackages()
+		// a API só aplica o filtro padrão (status aberto) quando nenhum filtro é enviado
+		var filters []openproject.Filter
+		if len(fields) > 0 {
+			ui.StartSpinner("Resolvendo filtros...")
+			filters, err = buildFilters(client, fields)
 			ui.StopSpinner()
 			if err != nil {
-				fmt.Fprintf(os.Stderr, "Erro ao listar Work Packages: %v\n", err)
+				fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
 				os.Exit(1)
 			}
+		}
 
-			fmt.Println(header.Render(fmt.Sprintf("Work Packages (%d)", len(workPackages))))
-			fmt.Println()
-
-			for _, wp := range workPackages {
-				id := idStyle.Render(fmt.Sprintf("#%-5d", wp.ID))
-				status := statusStyle(wp.Links.Status.Title).Render(fmt.Sprintf("%-12s", wp.Links.Status.Title))
-				assignee := renderAssignee(wp.Links.Assignee.Title)
-				subject := subjectStyle.Render(wp.Subject)
-
-				fmt.Printf("%s  %s  %s  %s\n", id, status, assignee, subject)
+		var workPackages []openproject.WorkPackage
+		var page *openproject.WorkPackagePage
+		fetch := func() error {
+			var err error
+			if listAll {
+				workPackages, err = client.ListAllWorkPackages(filters...)
+				return err
 			}
-			return
+			page, err = client.ListWorkPackages(listPage, listPageSize, filters...)
+			if err == nil {
+				workPackages = page.Items
+			}
+			return err
 		}
 
 		ui.StartSpinner("Carregando Work Packages...")
-		page, err := client.ListWorkPackages(listPage, listPageSize)
+		err = fetch()
 		ui.StopSpinner()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Erro ao listar Work Packages: %v\n", err)
 			os.Exit(1)
 		}
 
-		pageInfo := lipgloss.NewStyle().Foreground(warningColor)
-		fmt.Println(header.Render(fmt.Sprintf("Work Packages (%d total)", page.Total)))
-		fmt.Println(pageInfo.Render(fmt.Sprintf("Página %d de %d", page.Page, page.TotalPages)))
-		fmt.Println()
+		tracker := newChangeTracker()
+		draw := func() {
+			tracker.next()
+			renderWorkPackageList(workPackages, page, tracker)
+		}
 
-		for _, wp := range page.Items {
-			id := idStyle.Render(fmt.Sprintf("#%-5d", wp.ID))
-			status := statusStyle(wp.Links.Status.Title).Render(fmt.Sprintf("%-12s", wp.Links.Status.Title))
-			assignee := renderAssignee(wp.Links.Assignee.Title)
-			subject := subjectStyle.Render(wp.Subject)
+		if watchInterval == 0 {
+			draw()
+			return
+		}
+
+		// qualquer mudança no projeto pode fazer um work package entrar ou sair
+		// da lista, então o feed não usa os filtros da listagem
+		feed := newChangeFeed(client)
+		feed.prime(workPackages...)
+		watchLoop(feed, fetch, draw)
+	},
+}
 
-			fmt.Printf("%s  %s  %s  %s\n", id, status, assignee, subject)
+// renderWorkPackageList exibe a listagem; page é nil com --all.
+func renderWorkPackageList(workPackages []openproject.WorkPackage, page *openproject.WorkPackagePage, tracker *changeTracker) {
+	header := lipgloss.NewStyle().
+		Bold(true).
+		Foreground(primaryColor).
+		MarginBottom(1)
+
+	if page == nil {
+		fmt.Println(header.Render(fmt.Sprintf("Work Packages (%d)", len(workPackages))))
+		fmt.Println()
+
+		for _, wp := range workPackages {
+			fmt.Println(tracker.row(wp))
 		}
+		tracker.printRemoved()
+		return
+	}
+
+	pageInfo := lipgloss.NewStyle().Foreground(warningColor)
+	fmt.Println(header.Render(fmt.Sprintf("Work Packages (%d total)", page.Total)))
+	fmt.Println(pageInfo.Render(fmt.Sprintf("Página %d de %d", page.Page, page.TotalPages)))
+	fmt.Println()
+
+	for _, wp := range page.Items {
+		fmt.Println(tracker.row(wp))
+	}
+	tracker.printRemoved()
+
+	if page.HasNextPage {
+		fmt.Println()
+		fmt.Println(pageInfo.Render(fmt.Sprintf("Use --page %d para ver mais", page.Page+1)))
+	}
+}
 
-		if page.HasNextPage {
-			fmt.Println()
-			fmt.Println(pageInfo.Render(fmt.Sprintf("Use --page %d para ver mais", page.Page+1)))
+// listFilterFields junta o preset, os --filter e o --version, nessa ordem de
+// precedência crescente.
+func listFilterFields(cfg *config.Config) (map[string]string, error) {
+	fields := map[string]string{}
+
+	if listPreset != "" {
+		preset, ok := cfg.Presets[strings.ToLower(listPreset)]
+		if !ok {
+			return nil, fmt.Errorf("preset %q não existe (disponíveis: %s)", listPreset, strings.Join(presetNames(cfg), ", "))
 		}
-	},
+		for field, value := range preset {
+			fields[strings.ToLower(field)] = value
+		}
+	}
+
+	extra, err := parseAssignments(listFilters)
+	if err != nil {
+		return nil, fmt.Errorf("em --filter: %w", err)
+	}
+	maps.Copy(fields, extra)
+
+	if listVersion != "" {
+		fields["version"] = listVersion
+	}
+
+	return fields, nil
+}
+
+func presetNames(cfg *config.Config) []string {
+	names := slices.Collect(maps.Keys(cfg.Presets))
+	slices.Sort(names)
+	return names
 }
 
 func init() {
 	wpListCmd.Flags().IntVarP(&listPage, "page", "p", 1, "Número da página")
 	wpListCmd.Flags().IntVarP(&listPageSize, "size", "s", 70, "Itens por página")
 	wpListCmd.Flags().BoolVarP(&listAll, "all", "a", false, "Lista todos os Work Packages")
+	wpListCmd.Flags().StringVar(&listVersion, "version", "", "Lista apenas os Work Packages da versão/sprint (nome ou ID)")
+	wpListCmd.Flags().StringArrayVar(&listFilters, "filter", nil, "Filtro campo=valor, como no bulk update (pode repetir)")
+	wpListCmd.Flags().StringVar(&listPreset, "preset", "", "Usa um conjunto de filtros da seção presets da configuração")
+	addWatchFlag(wpListCmd)
 	wpCmd.AddCommand(wpListCmd)
 }
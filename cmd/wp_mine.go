@@ -0,0 +1,263 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var mineDays int
+
+var wpMineCmd = &cobra.Command{
+	Use:   "mine",
+	Short: "Painel com o seu trabalho: atribuídos, responsável, observados e atrasados",
+	Long: `Mostra, em seções, os Work Packages abertos atribuídos a você (agrupados por
+status), aqueles em que você é o responsável, os que você observa e mudaram
+nos últimos dias e os que passaram do prazo.
+
+Exemplos:
+  op wp mine
+  op wp mine --days 3
+  op wp mine --watch=1m`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		if err := checkWatchInterval(); err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+
+		var dash *mineDashboard
+		fetch := func() error {
+			var err error
+			dash, err = fetchMineDashboard(client, time.Now().AddDate(0, 0, -mineDays))
+			return err
+		}
+
+		ui.StartSpinner("Carregando seu trabalho...")
+		user, err := client.GetCurrentUser()
+		if err == nil {
+			err = fetch()
+		}
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar o painel: %v\n", err)
+			os.Exit(1)
+		}
+
+		tracker := newChangeTracker()
+		draw := func() {
+			tracker.next()
+			fmt.Println(headerBox.Render(titleStyle.Render("Meu trabalho") + "  " + valueStyle.Render(user.Name)))
+			renderMineDashboard(dash, time.Now(), tracker)
+		}
+
+		if watchInterval == 0 {
+			draw()
+			return
+		}
+
+		feed := newChangeFeed(client)
+		feed.prime(dash.Assigned...)
+		feed.prime(dash.Responsible...)
+		feed.prime(dash.Watched...)
+		watchLoop(feed, fetch, draw)
+	},
+}
+
+// mineDashboard são as listas exibidas no painel do "wp mine".
+type mineDashboard struct {
+	Statuses    []openproject.Status
+	Assigned    []openproject.WorkPackage
+	Responsible []openproject.WorkPackage
+	Watched     []openproject.WorkPackage
+}
+
+func fetchMineDashboard(client *openproject.Client, since time.Time) (*mineDashboard, error) {
+	me := func(field string) openproject.Filter {
+		return openproject.Filter{Name: field, Operator: "=", Values: []string{"me"}}
+	}
+
+	dash := &mineDashboard{}
+	queries := []struct {
+		dest    *[]openproject.WorkPackage
+		filters []openproject.Filter
+	}{
+		{&dash.Assigned, []openproject.Filter{openproject.OpenStatusFilter(), me("assignee")}},
+		{&dash.Responsible, []openproject.Filter{openproject.OpenStatusFilter(), me("responsible")}},
+		{&dash.Watched, []openproject.Filter{openproject.AnyStatusFilter(), me("watcher"), openproject.UpdatedSinceFilter(since)}},
+	}
+
+	errs := make([]error, len(queries)+1)
+	forEachConcurrently(len(queries)+1, len(queries)+1, func(i int) {
+		if i == len(queries) {
+			// só define a ordem dos grupos; sem ela, usa a ordem de aparição
+			dash.Statuses, _ = client.ListStatuses()
+			return
+		}
+		*queries[i].dest, errs[i] = client.ListAllWorkPackages(queries[i].filters...)
+	})
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sort.SliceStable(dash.Watched, func(i, j int) bool {
+		return dash.Watched[i].UpdatedAt > dash.Watched[j].UpdatedAt
+	})
+
+	return dash, nil
+}
+
+func renderMineDashboard(dash *mineDashboard, now time.Time, tracker *changeTracker) {
+	section := lipgloss.NewStyle().Bold(true).Foreground(primaryColor).MarginTop(1)
+	groupStyle := lipgloss.NewStyle().Foreground(mutedColor).PaddingLeft(2)
+	muted := lipgloss.NewStyle().Foreground(mutedColor)
+	overdueStyle := lipgloss.NewStyle().Foreground(errorColor)
+	empty := func(msg string) { fmt.Println(muted.Render("  " + msg)) }
+
+	fmt.Println(section.Render(fmt.Sprintf("Atribuídos a mim (%d)", len(dash.Assigned))))
+	if len(dash.Assigned) == 0 {
+		empty("Nada atribuído a você")
+	}
+	for _, g := range groupByStatus(dash.Assigned, dash.Statuses) {
+		fmt.Println(groupStyle.Render(fmt.Sprintf("%s (%d)", g.status, len(g.items))))
+		for _, wp := range g.items {
+			fmt.Println("  " + tracker.row(wp))
+		}
+	}
+
+	fmt.Println(section.Render(fmt.Sprintf("Sou responsável (%d)", len(dash.Responsible))))
+	if len(dash.Responsible) == 0 {
+		empty("Nenhum work package em que você é o responsável")
+	}
+	for _, wp := range dash.Responsible {
+		fmt.Println("  " + tracker.row(wp))
+	}
+
+	fmt.Println(section.Render(fmt.Sprintf("Observados com mudanças recentes (%d)", len(dash.Watched))))
+	if len(dash.Watched) == 0 {
+		empty(fmt.Sprintf("Nenhuma mudança nos últimos %d dias", mineDays))
+	}
+	for _, wp := range dash.Watched {
+		fmt.Println("  " + tracker.row(wp) + "  " + muted.Render(timeAgo(wp.UpdatedAt, now)))
+	}
+
+	overdue := overdueWorkPackages(now, dash.Assigned, dash.Responsible)
+	fmt.Println(section.Render(fmt.Sprintf("Atrasados (%d)", len(overdue))))
+	if len(overdue) == 0 {
+		empty("Nenhum prazo vencido")
+	}
+	for _, wp := range overdue {
+		due, _ := time.Parse("2006-01-02", wp.DueDate)
+		days := int(dateOnly(now).Sub(due).Hours() / 24)
+		note := fmt.Sprintf("prazo %s, %d dia(s) de atraso", due.Format("02/01"), days)
+		fmt.Println("  " + tracker.row(wp) + "  " + overdueStyle.Render(note))
+	}
+	tracker.printRemoved()
+}
+
+type statusGroup struct {
+	status string
+	items  []openproject.WorkPackage
+}
+
+// groupByStatus agrupa na ordem dos status do servidor; status desconhecidos
+// vão para o fim, na ordem em que aparecem.
+func groupByStatus(wps []openproject.WorkPackage, statuses []openproject.Status) []statusGroup {
+	position := make(map[string]int, len(statuses))
+	for i, s := range statuses {
+		position[s.Name] = i
+	}
+
+	var groups []statusGroup
+	index := map[string]int{}
+	for _, wp := range wps {
+		name := wp.Links.Status.Title
+		i, ok := index[name]
+		if !ok {
+			i = len(groups)
+			index[name] = i
+			groups = append(groups, statusGroup{status: name})
+		}
+		groups[i].items = append(groups[i].items, wp)
+	}
+
+	rank := func(name string) int {
+		if p, ok := position[name]; ok {
+			return p
+		}
+		return len(position)
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return rank(groups[i].status) < rank(groups[j].status) })
+
+	return groups
+}
+
+// overdueWorkPackages retorna os work packages (sem repetir) com prazo antes
+// de hoje, do mais atrasado para o mais recente.
+func overdueWorkPackages(now time.Time, lists ...[]openproject.WorkPackage) []openproject.WorkPackage {
+	today := dateOnly(now).Format("2006-01-02")
+	seen := map[int]bool{}
+
+	var overdue []openproject.WorkPackage
+	for _, list := range lists {
+		for _, wp := range list {
+			if wp.DueDate == "" || wp.DueDate >= today || seen[wp.ID] {
+				continue
+			}
+			seen[wp.ID] = true
+			overdue = append(overdue, wp)
+		}
+	}
+
+	sort.SliceStable(overdue, func(i, j int) bool { return overdue[i].DueDate < overdue[j].DueDate })
+	return overdue
+}
+
+func dateOnly(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
+
+// timeAgo descreve um instante RFC3339 em relação a now, ex: "há 3 h".
+func timeAgo(value string, now time.Time) string {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return ""
+	}
+
+	d := now.Sub(t)
+	switch {
+	case d < time.Minute:
+		return "agora"
+	case d < time.Hour:
+		return fmt.Sprintf("há %d min", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("há %d h", int(d.Hours()))
+	case d < 48*time.Hour:
+		return "ontem"
+	default:
+		return fmt.Sprintf("há %d dias", int(d.Hours()/24))
+	}
+}
+
+func init() {
+	wpMineCmd.Flags().IntVar(&mineDays, "days", 7, "Janela, em dias, das mudanças nos Work Packages observados")
+	addWatchFlag(wpMineCmd)
+	wpCmd.AddCommand(wpMineCmd)
+}
@@ -0,0 +1,85 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/guialveess/opencli/internal/browser"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/git"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var openInBrowser bool
+
+var wpOpenCmd = &cobra.Command{
+	Use:   "open [id]",
+	Short: "Abre um Work Package no navegador",
+	Long: `Abre o Work Package no navegador padrão.
+
+Sem ID, usa o número presente no nome do branch git atual
+(ex: feature/1234-login abre o #1234).`,
+	Args: cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := resolveWorkPackageID(args)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+		openURL(client.WorkPackageURL(id))
+	},
+}
+
+// resolveWorkPackageID lê o ID do primeiro argumento ou, sem argumentos,
+// do branch git atual.
+func resolveWorkPackageID(args []string) (int, error) {
+	if len(args) > 0 {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return 0, fmt.Errorf("ID inválido: %s", args[0])
+		}
+		return id, nil
+	}
+
+	id, err := git.CurrentWorkPackageID()
+	if err != nil {
+		return 0, fmt.Errorf("informe o ID do Work Package: %v", err)
+	}
+	return id, nil
+}
+
+// openURL abre url no navegador; sem display (ex: via SSH) apenas a imprime.
+func openURL(url string) {
+	err := browser.Open(url)
+	if errors.Is(err, browser.ErrNoDisplay) {
+		fmt.Println(url)
+		return
+	}
+	if err != nil {
+		ui.PrintError(fmt.Sprintf("Erro ao abrir o navegador: %v", err))
+		fmt.Println(url)
+		return
+	}
+	ui.PrintInfo(fmt.Sprintf("Abrindo %s", url))
+}
+
+// addOpenFlag registra --open nos comandos que criam Work Packages.
+func addOpenFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&openInBrowser, "open", false, "Abrir o Work Package no navegador após criar")
+}
+
+func init() {
+	wpCmd.AddCommand(wpOpenCmd)
+}
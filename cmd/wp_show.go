@@ -3,6 +3,7 @@ package cmd
 import (
 	"fmt"
 	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -14,11 +15,16 @@ import (
 	"github.com/spf13/cobra"
 )
 
+var showRaw bool
+
 var wpShowCmd = &cobra.Command{
 	Use:   "show <id>",
 	Short: "Exibe detalhes de um Work Package",
-	Long:  "Exibe os detalhes de um Work Package específico pelo ID.",
-	Args:  cobra.ExactArgs(1),
+	Long: `Exibe os detalhes de um Work Package específico pelo ID.
+
+Com --watch a tela é atualizada quando o Work Package muda, destacando
+alterações de status e assignee.`,
+	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
 		id, err := strconv.Atoi(args[0])
 		if err != nil {
@@ -32,17 +38,53 @@ var wpShowCmd = &cobra.Command{
 			os.Exit(1)
 		}
 
+		if err := checkWatchInterval(); err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
 		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
 
+		var wp *openproject.WorkPackage
+		var labels map[string]string
+		fetch := func() error {
+			var err error
+			wp, err = client.GetWorkPackage(id)
+			if err == nil && labels == nil && len(wp.CustomFields) > 0 {
+				// sem os nomes do schema os campos aparecem como customFieldN
+				labels, _ = client.GetCustomFieldLabels(wp.Links.Schema.Href)
+			}
+			return err
+		}
+
 		ui.StartSpinner("Carregando Work Package...")
-		wp, err := client.GetWorkPackage(id)
+		err = fetch()
 		ui.StopSpinner()
 		if err != nil {
 			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
 			os.Exit(1)
 		}
 
-		renderWorkPackage(wp)
+		if watchInterval == 0 {
+			renderWorkPackage(wp, labels)
+			copyWorkPackageLink(client, wp.ID, wp.Subject)
+			return
+		}
+		copyWorkPackageLink(client, wp.ID, wp.Subject)
+
+		tracker := newChangeTracker()
+		draw := func() {
+			tracker.next()
+			if changes, _ := tracker.diff(*wp); len(changes) > 0 {
+				fmt.Println(changedMarkStyle.Render("● Mudou: ") + changedNoteStyle.Render(strings.Join(changes, ", ")))
+			}
+			renderWorkPackage(wp, labels)
+		}
+
+		// o work package pode ser de outro projeto, então o feed consulta todos
+		feed := newChangeFeed(openproject.NewClient(cfg.BaseURL, cfg.APIKey, ""), idFilter("id", id))
+		feed.prime(*wp)
+		watchLoop(feed, fetch, draw)
 	},
 }
 
@@ -86,7 +128,9 @@ var wpAssignMeCmd = &cobra.Command{
 	},
 }
 
-func renderWorkPackage(wp *openproject.WorkPackage) {
+// renderWorkPackage exibe o work package. labels traduz customFieldN para o
+// nome configurado no OpenProject; pode ser nil.
+func renderWorkPackage(wp *openproject.WorkPackage, labels map[string]string) {
 	idText := lipgloss.NewStyle().
 		Bold(true).
 		Foreground(lipgloss.Color("#A78BFA")).
@@ -104,30 +148,68 @@ func renderWorkPackage(wp *openproject.WorkPackage) {
 	fmt.Println(header)
 	fmt.Println()
 
-	props := []struct {
+	type prop struct {
 		label string
 		value string
 		style lipgloss.Style
-	}{
+	}
+
+	userStyle := lipgloss.NewStyle().Foreground(lipgloss.Color("#60A5FA"))
+
+	props := []prop{
 		{"Status", wp.Links.Status.Title, statusStyle(wp.Links.Status.Title)},
 		{"Tipo", wp.Links.Type.Title, propValueStyle},
 		{"Prioridade", wp.Links.Priority.Title, propValueStyle},
+		{"Assignee", wp.Links.Assignee.Title, userStyle},
+		{"Responsável", wp.Links.Responsible.Title, userStyle},
+		{"Autor", wp.Links.Author.Title, userStyle},
+		{"Versão", wp.Links.Version.Title, propValueStyle},
+		{"Categoria", wp.Links.Category.Title, propValueStyle},
+		{"Início", formatDay(wp.StartDate), propValueStyle},
+		{"Prazo", formatDay(wp.DueDate), dueDateStyle(wp)},
+		{"Estimado", openproject.FormatHours(wp.EstimatedTime), propValueStyle},
+		{"Restante", openproject.FormatHours(wp.RemainingTime), propValueStyle},
+		{"Gasto", openproject.FormatHours(wp.SpentTime), propValueStyle},
 	}
 
-	if wp.Links.Assignee.Title != "" {
-		props = append(props, struct {
-			label string
-			value string
-			style lipgloss.Style
-		}{"Assignee", wp.Links.Assignee.Title, lipgloss.NewStyle().Foreground(lipgloss.Color("#60A5FA"))})
+	if wp.PercentageDone != nil {
+		props = append(props, prop{"Progresso", fmt.Sprintf("%d%%", *wp.PercentageDone), propValueStyle})
+	}
+	if wp.StoryPoints != nil {
+		props = append(props, prop{"Story Points", strconv.Itoa(*wp.StoryPoints), propValueStyle})
 	}
 
-	for _, prop := range props {
-		label := propLabelStyle.Render(prop.label)
-		value := prop.style.Render(prop.value)
+	for _, p := range props {
+		if p.value == "" {
+			continue
+		}
+		label := propLabelStyle.Render(p.label)
+		value := p.style.Render(p.value)
 		fmt.Printf("%s %s\n", label, value)
 	}
 
+	if len(wp.CustomFields) > 0 {
+		keys := make([]string, 0, len(wp.CustomFields))
+		for key := range wp.CustomFields {
+			keys = append(keys, key)
+		}
+		sort.Slice(keys, func(i, j int) bool {
+			return customFieldLabel(keys[i], labels) < customFieldLabel(keys[j], labels)
+		})
+
+		width := 14
+		for _, key := range keys {
+			width = max(width, lipgloss.Width(customFieldLabel(key, labels))+2)
+		}
+		customLabelStyle := propLabelStyle.Width(width)
+
+		fmt.Println()
+		for _, key := range keys {
+			label := customLabelStyle.Render(customFieldLabel(key, labels))
+			fmt.Printf("%s %s\n", label, propValueStyle.Render(wp.CustomFields[key]))
+		}
+	}
+
 	fmt.Println()
 
 	dateStyle := lipgloss.NewStyle().Foreground(mutedColor)
@@ -144,13 +226,57 @@ func renderWorkPackage(wp *openproject.WorkPackage) {
 			Render("Descrição")
 		fmt.Println(descTitle)
 
-		desc := descriptionStyle.Render(strings.TrimSpace(wp.Description.Raw))
-		fmt.Println(desc)
+		fmt.Println(renderDescription(wp.Description.Raw))
 	}
 
 	fmt.Println()
 }
 
+// renderDescription formata o markdown da descrição para o terminal, ou o
+// exibe como está com --raw (ou se a renderização falhar).
+func renderDescription(raw string) string {
+	raw = strings.TrimSpace(raw)
+
+	if !showRaw {
+		width := min(ui.TerminalWidth(100), 120) - 4
+		if rendered, err := ui.RenderMarkdown(raw, width); err == nil {
+			return rendered
+		}
+	}
+
+	return descriptionStyle.Render(raw)
+}
+
+func customFieldLabel(key string, labels map[string]string) string {
+	if label, ok := labels[key]; ok {
+		return label
+	}
+	return key
+}
+
+// dueDateStyle destaca o prazo quando ele já passou e o work package segue aberto.
+func dueDateStyle(wp *openproject.WorkPackage) lipgloss.Style {
+	due, err := time.Parse("2006-01-02", wp.DueDate)
+	if err != nil || !due.Before(time.Now().Truncate(24*time.Hour)) {
+		return propValueStyle
+	}
+
+	switch wp.Links.Status.Title {
+	case "Done", "Closed":
+		return propValueStyle
+	}
+	return lipgloss.NewStyle().Bold(true).Foreground(errorColor)
+}
+
+// formatDay formata datas sem hora da API (2006-01-02) como 02/01/2006.
+func formatDay(day string) string {
+	t, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		return day
+	}
+	return t.Format("02/01/2006")
+}
+
 func formatDate(isoDate string) string {
 	t, err := time.Parse(time.RFC3339, isoDate)
 	if err != nil {
@@ -160,6 +286,9 @@ func formatDate(isoDate string) string {
 }
 
 func init() {
+	wpShowCmd.Flags().BoolVar(&showRaw, "raw", false, "Exibe a descrição em markdown sem formatação")
+	addCopyFlag(wpShowCmd)
+	addWatchFlag(wpShowCmd)
 	wpCmd.AddCommand(wpShowCmd)
 	wpCmd.AddCommand(wpAssignMeCmd)
 }
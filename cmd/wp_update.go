@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var (
+	updateSubject  string
+	updateStatus   string
+	updateAssignee string
+	updateVersion  string
+)
+
+var wpUpdateCmd = &cobra.Command{
+	Use:   "update <id>",
+	Short: "Atualiza um Work Package",
+	Long: `Atualiza campos de um Work Package.
+
+Exemplos:
+  op wp update 123 --status "In Progress"
+  op wp update 123 --assignee me
+  op wp update 123 --assignee none          # remove o assignee
+  op wp update 123 --version "Sprint 42"
+  op wp update 123 --add-watcher ana --add-watcher 42`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "ID inválido: %s\n", args[0])
+			os.Exit(1)
+		}
+
+		cfg, err := config.Load()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+			os.Exit(1)
+		}
+
+		client := openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+
+		fields := map[string]string{}
+		if updateSubject != "" {
+			fields["subject"] = updateSubject
+		}
+		if updateStatus != "" {
+			fields["status"] = updateStatus
+		}
+		if updateAssignee != "" {
+			fields["assignee"] = updateAssignee
+		}
+		if updateVersion != "" {
+			fields["version"] = updateVersion
+		}
+
+		if len(fields) == 0 && len(addWatchers) == 0 {
+			fmt.Fprintln(os.Stderr, "Nada para atualizar: informe ao menos um campo")
+			os.Exit(1)
+		}
+
+		if len(fields) > 0 {
+			ui.StartSpinner("Resolvendo campos...")
+			patch, _, err := buildPatch(client, fields)
+			ui.StopSpinner()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+				os.Exit(1)
+			}
+
+			ui.StartSpinner("Atualizando Work Package...")
+			_, err = updateWithRetry(client, id, patch)
+			ui.StopSpinner()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Erro ao atualizar Work Package: %v\n", err)
+				os.Exit(1)
+			}
+
+			fmt.Printf("Work Package #%d atualizado com sucesso!\n", id)
+		}
+
+		addWatchersTo(client, id)
+	},
+}
+
+// buildPatch converte pares campo=valor em um patch, resolvendo nomes para os
+// hrefs da API. Campos de usuário aceitam "me", ID, login ou nome; usuários e
+// version aceitam "none" para limpar. Também retorna, por campo, o nome do
+// valor resolvido para exibição.
+func buildPatch(client *openproject.Client, fields map[string]string) (*openproject.WorkPackagePatch, map[string]string, error) {
+	patch := &openproject.WorkPackagePatch{Links: map[string]string{}}
+	labels := make(map[string]string, len(fields))
+
+	for field, value := range fields {
+		field = strings.ToLower(field)
+
+		switch field {
+		case "subject":
+			patch.Subject = value
+			labels[field] = value
+		case "status":
+			status, err := client.FindStatus(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			patch.Links["status"] = fmt.Sprintf("/api/v3/statuses/%d", status.ID)
+			labels[field] = status.Name
+		case "type":
+			t, err := client.FindType(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			patch.Links["type"] = fmt.Sprintf("/api/v3/types/%d", t.ID)
+			labels[field] = t.Name
+		case "priority":
+			p, err := client.FindPriority(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			patch.Links["priority"] = fmt.Sprintf("/api/v3/priorities/%d", p.ID)
+			labels[field] = p.Name
+		case "version":
+			if strings.EqualFold(value, "none") {
+				patch.Links["version"] = ""
+				labels[field] = ""
+				continue
+			}
+			v, err := client.FindVersion(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			patch.Links["version"] = fmt.Sprintf("/api/v3/versions/%d", v.ID)
+			labels[field] = v.Name
+		case "assignee", "responsible":
+			if strings.EqualFold(value, "none") {
+				patch.Links[field] = ""
+				labels[field] = ""
+				continue
+			}
+			user, err := client.FindUser(value)
+			if err != nil {
+				return nil, nil, err
+			}
+			patch.Links[field] = fmt.Sprintf("/api/v3/users/%d", user.ID)
+			labels[field] = user.Name
+		default:
+			return nil, nil, fmt.Errorf("campo desconhecido: %s (use subject, status, type, priority, version, assignee ou responsible)", field)
+		}
+	}
+
+	return patch, labels, nil
+}
+
+// updateWithRetry busca o lockVersion atual e aplica o patch, tentando de novo
+// uma vez se outra pessoa alterou o work package no meio do caminho.
+func updateWithRetry(client *openproject.Client, id int, patch *openproject.WorkPackagePatch) (*openproject.WorkPackage, error) {
+	var lastErr error
+
+	for attempt := 0; attempt < 2; attempt++ {
+		wp, err := client.GetWorkPackage(id)
+		if err != nil {
+			return nil, err
+		}
+
+		updated, err := client.UpdateWorkPackage(id, wp.LockVersion, patch)
+		if !errors.Is(err, openproject.ErrConflict) {
+			return updated, err
+		}
+		lastErr = err
+	}
+
+	return nil, lastErr
+}
+
+func init() {
+	wpUpdateCmd.Flags().StringVar(&updateSubject, "subject", "", "Novo título")
+	wpUpdateCmd.Flags().StringVar(&updateStatus, "status", "", "Novo status (ex: \"In Progress\")")
+	wpUpdateCmd.Flags().StringVar(&updateAssignee, "assignee", "", "Novo assignee: me, ID, login, nome ou none")
+	wpUpdateCmd.Flags().StringVar(&updateVersion, "version", "", "Nova versão/sprint (nome, ID ou none)")
+	addWatcherFlag(wpUpdateCmd)
+	wpCmd.AddCommand(wpUpdateCmd)
+}
@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/openproject"
+	"github.com/guialveess/opencli/internal/ui"
+	"github.com/spf13/cobra"
+)
+
+var addWatchers []string
+
+var wpWatchCmd = &cobra.Command{
+	Use:   "watch <id>",
+	Short: "Passa a observar um Work Package",
+	Long:  "Adiciona você como observador do Work Package, recebendo notificações das mudanças.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, client := watcherCommandSetup(args)
+
+		ui.StartSpinner("Obtendo informações do usuário...")
+		user, err := client.GetCurrentUser()
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao obter usuário: %v\n", err)
+			os.Exit(1)
+		}
+
+		ui.StartSpinner("Adicionando observador...")
+		err = client.AddWatcher(id, user.ID)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Você agora observa o Work Package #%d\n", id)
+	},
+}
+
+var wpUnwatchCmd = &cobra.Command{
+	Use:   "unwatch <id>",
+	Short: "Deixa de observar um Work Package",
+	Long:  "Remove você da lista de observadores do Work Package.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, client := watcherCommandSetup(args)
+
+		ui.StartSpinner("Obtendo informações do usuário...")
+		user, err := client.GetCurrentUser()
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro ao obter usuário: %v\n", err)
+			os.Exit(1)
+		}
+
+		ui.StartSpinner("Removendo observador...")
+		err = client.RemoveWatcher(id, user.ID)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		fmt.Printf("Você deixou de observar o Work Package #%d\n", id)
+	},
+}
+
+var wpWatchersCmd = &cobra.Command{
+	Use:   "watchers <id>",
+	Short: "Lista os observadores de um Work Package",
+	Long:  "Lista os usuários que observam o Work Package.",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		id, client := watcherCommandSetup(args)
+
+		ui.StartSpinner("Carregando observadores...")
+		watchers, err := client.ListWatchers(id)
+		ui.StopSpinner()
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Erro: %v\n", err)
+			os.Exit(1)
+		}
+
+		header := lipgloss.NewStyle().
+			Bold(true).
+			Foreground(primaryColor).
+			MarginBottom(1)
+
+		fmt.Println(header.Render(fmt.Sprintf("Observadores de #%d (%d)", id, len(watchers))))
+		for _, w := range watchers {
+			fmt.Printf("  %s\n", renderAssignee(w.Name))
+		}
+	},
+}
+
+func watcherCommandSetup(args []string) (int, *openproject.Client) {
+	id, err := strconv.Atoi(args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "ID inválido: %s\n", args[0])
+		os.Exit(1)
+	}
+
+	cfg, err := config.Load()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Erro ao carregar configuração: %v\n", err)
+		os.Exit(1)
+	}
+
+	return id, openproject.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Project)
+}
+
+// addWatcherFlag registra --add-watcher nos comandos de criação e atualização.
+func addWatcherFlag(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&addWatchers, "add-watcher", nil, "Adiciona um observador (me, ID, login ou nome); pode ser repetido")
+}
+
+// addWatchersTo adiciona os usuários de --add-watcher como observadores de id.
+func addWatchersTo(client *openproject.Client, id int) {
+	for _, query := range addWatchers {
+		ui.StartSpinner(fmt.Sprintf("Adicionando observador %s...", query))
+		user, err := client.FindUser(query)
+		if err == nil {
+			err = client.AddWatcher(id, user.ID)
+		}
+		ui.StopSpinner()
+
+		if err != nil {
+			ui.PrintError(fmt.Sprintf("Erro ao adicionar observador %s: %v", query, err))
+			continue
+		}
+		ui.PrintSuccess(fmt.Sprintf("Observador adicionado: %s", user.Name))
+	}
+}
+
+func init() {
+	wpCmd.AddCommand(wpWatchCmd)
+	wpCmd.AddCommand(wpUnwatchCmd)
+	wpCmd.AddCommand(wpWatchersCmd)
+}
@@ -0,0 +1,76 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+)
+
+type ImageAnalysis struct {
+	Title       string
+	Description string
+}
+
+// AnalyzeScreenshots envia as imagens (já preparadas com PrepareImages), em ordem,
+// com o prompt (veja RenderPrompt) e extrai título e descrição da resposta.
+func AnalyzeScreenshots(ctx context.Context, p Provider, images [][]byte, prompt string, onToken func(string)) (*ImageAnalysis, error) {
+	response, err := p.Generate(ctx, prompt, images, onToken)
+	if err != nil {
+		return nil, err
+	}
+
+	if os.Getenv("DEBUG") == "1" {
+		fmt.Fprintf(os.Stderr, "[DEBUG] Resposta do modelo:\n%s\n---\n", response)
+	}
+
+	return parseAnalysisResponse(response), nil
+}
+
+func parseAnalysisResponse(response string) *ImageAnalysis {
+	response = strings.TrimSpace(response)
+
+	analysis := &ImageAnalysis{
+		Title:       "Análise de screenshot",
+		Description: response,
+	}
+
+	if response == "" {
+		analysis.Description = "(O modelo não retornou uma descrição)"
+		return analysis
+	}
+
+	lines := strings.Split(response, "\n")
+	var descStartIndex int = -1
+
+	for i, line := range lines {
+		lineUpper := strings.ToUpper(strings.TrimSpace(line))
+
+		if strings.HasPrefix(lineUpper, "TITULO:") || strings.HasPrefix(lineUpper, "TÍTULO:") || strings.HasPrefix(lineUpper, "TITLE:") {
+			colonIdx := strings.Index(line, ":")
+			if colonIdx != -1 && colonIdx < len(line)-1 {
+				analysis.Title = strings.TrimSpace(line[colonIdx+1:])
+			}
+		}
+
+		if strings.HasPrefix(lineUpper, "DESCRICAO:") || strings.HasPrefix(lineUpper, "DESCRIÇÃO:") || strings.HasPrefix(lineUpper, "DESCRIPTION:") {
+			colonIdx := strings.Index(line, ":")
+			descStartIndex = i
+			if colonIdx != -1 && colonIdx < len(line)-1 {
+				firstPart := strings.TrimSpace(line[colonIdx+1:])
+				if firstPart != "" {
+					lines[i] = firstPart
+				} else {
+					descStartIndex = i + 1
+				}
+			}
+			break
+		}
+	}
+
+	if descStartIndex >= 0 && descStartIndex < len(lines) {
+		analysis.Description = strings.TrimSpace(strings.Join(lines[descStartIndex:], "\n"))
+	}
+
+	return analysis
+}
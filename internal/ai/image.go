@@ -0,0 +1,120 @@
+package ai
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	// formatos aceitos pelo image.Decode
+	_ "image/gif"
+	_ "image/jpeg"
+
+	"golang.org/x/image/draw"
+
+	_ "golang.org/x/image/bmp"
+	_ "golang.org/x/image/webp"
+)
+
+// ImageOptions controla o pré-processamento das imagens antes de enviá-las ao modelo.
+type ImageOptions struct {
+	MaxDimension int              // maior lado em pixels; 0 mantém o tamanho original
+	Crop         *image.Rectangle // região a recortar, em coordenadas da imagem original
+}
+
+// PrepareImage decodifica a imagem em path, aplica recorte e redimensionamento e
+// a reencoda como PNG. O arquivo original não é alterado.
+func PrepareImage(path string, opts ImageOptions) ([]byte, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao ler imagem: %w", err)
+	}
+	defer f.Close()
+
+	img, _, err := image.Decode(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s não é uma imagem suportada (PNG, JPEG, GIF, WebP ou BMP)", filepath.Base(path))
+	}
+
+	if opts.Crop != nil {
+		region := opts.Crop.Add(img.Bounds().Min).Intersect(img.Bounds())
+		if region.Empty() {
+			return nil, fmt.Errorf("recorte %s fora dos limites de %s (%dx%d)",
+				formatRect(*opts.Crop), filepath.Base(path), img.Bounds().Dx(), img.Bounds().Dy())
+		}
+		cropped := image.NewRGBA(image.Rect(0, 0, region.Dx(), region.Dy()))
+		draw.Draw(cropped, cropped.Bounds(), img, region.Min, draw.Src)
+		img = cropped
+	}
+
+	img = downscale(img, opts.MaxDimension)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("erro ao converter %s para PNG: %w", filepath.Base(path), err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// PrepareImages aplica PrepareImage a cada caminho, mantendo a ordem.
+func PrepareImages(paths []string, opts ImageOptions) ([][]byte, error) {
+	images := make([][]byte, 0, len(paths))
+	for _, path := range paths {
+		data, err := PrepareImage(path, opts)
+		if err != nil {
+			return nil, err
+		}
+		images = append(images, data)
+	}
+	return images, nil
+}
+
+func downscale(img image.Image, maxDimension int) image.Image {
+	bounds := img.Bounds()
+	w, h := bounds.Dx(), bounds.Dy()
+
+	if maxDimension <= 0 || (w <= maxDimension && h <= maxDimension) {
+		return img
+	}
+
+	scale := float64(maxDimension) / float64(max(w, h))
+	newW := max(1, int(float64(w)*scale))
+	newH := max(1, int(float64(h)*scale))
+
+	dst := image.NewRGBA(image.Rect(0, 0, newW, newH))
+	draw.CatmullRom.Scale(dst, dst.Bounds(), img, bounds, draw.Over, nil)
+	return dst
+}
+
+// ParseCrop interpreta uma região no formato "x,y,w,h".
+func ParseCrop(s string) (*image.Rectangle, error) {
+	parts := strings.Split(s, ",")
+	if len(parts) != 4 {
+		return nil, fmt.Errorf("recorte inválido %q: use x,y,w,h", s)
+	}
+
+	values := make([]int, 4)
+	for i, part := range parts {
+		v, err := strconv.Atoi(strings.TrimSpace(part))
+		if err != nil || v < 0 {
+			return nil, fmt.Errorf("recorte inválido %q: use inteiros não negativos x,y,w,h", s)
+		}
+		values[i] = v
+	}
+
+	if values[2] == 0 || values[3] == 0 {
+		return nil, fmt.Errorf("recorte inválido %q: largura e altura devem ser maiores que zero", s)
+	}
+
+	rect := image.Rect(values[0], values[1], values[0]+values[2], values[1]+values[3])
+	return &rect, nil
+}
+
+func formatRect(r image.Rectangle) string {
+	return fmt.Sprintf("%d,%d,%d,%d", r.Min.X, r.Min.Y, r.Dx(), r.Dy())
+}
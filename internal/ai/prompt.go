@@ -0,0 +1,133 @@
+package ai
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// PromptData são as variáveis disponíveis nos templates de prompt.
+// ImageCount é o número de imagens enviadas junto com o prompt.
+type PromptData struct {
+	Project    string
+	Types      []string
+	Lang       string
+	Hint       string
+	ImageCount int
+}
+
+var defaultPrompts = map[string]string{
+	"pt": `{{ if gt .ImageCount 1 -}}
+Estas são {{ .ImageCount }} capturas de tela, em ordem, de um software, terminal, IDE ou navegador.
+Elas mostram uma sequência (antes/depois ou passos de um fluxo) do mesmo problema.
+{{- else -}}
+Esta é uma captura de tela de um software, terminal, IDE ou navegador.
+{{- end }}
+Você é um desenvolvedor analisando um bug ou problema técnico{{ if .Project }} do projeto {{ .Project }}{{ end }}.
+{{ if .Hint }}
+Contexto informado pelo usuário: {{ .Hint }}
+{{ end }}
+Analise a imagem e forneça:
+1. Um título curto (máximo 80 caracteres) descrevendo o problema ou erro
+2. Uma descrição técnica do que você vê: mensagens de erro, stack traces, problemas de UI, etc.
+{{- if gt .ImageCount 1 }}
+   Descreva os passos na ordem das imagens (Imagem 1, Imagem 2...) e consolide em um único problema.
+{{- end }}
+
+Se houver texto de erro visível, transcreva-o exatamente.
+Se for código, identifique a linguagem e o problema.
+
+Responda em português no formato:
+TITULO: <título técnico do problema>
+DESCRICAO: <descrição técnica detalhada>`,
+
+	"en": `{{ if gt .ImageCount 1 -}}
+These are {{ .ImageCount }} screenshots, in order, of a piece of software, a terminal, an IDE or a browser.
+They show a sequence (before/after or steps of a flow) of the same problem.
+{{- else -}}
+This is a screenshot of a piece of software, a terminal, an IDE or a browser.
+{{- end }}
+You are a developer analyzing a bug or technical issue{{ if .Project }} in the {{ .Project }} project{{ end }}.
+{{ if .Hint }}
+Context provided by the user: {{ .Hint }}
+{{ end }}
+Analyze the image and provide:
+1. A short title (at most 80 characters) describing the problem or error
+2. A technical description of what you see: error messages, stack traces, UI issues, etc.
+{{- if gt .ImageCount 1 }}
+   Describe the steps following the image order (Image 1, Image 2...) and consolidate them into a single problem.
+{{- end }}
+
+If there is visible error text, transcribe it exactly.
+If it is code, identify the language and the problem.
+
+Answer in English using the format:
+TITLE: <technical title of the problem>
+DESCRIPTION: <detailed technical description>`,
+}
+
+var templateFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// RenderPrompt monta o prompt de análise. Com name vazio usa o template padrão do
+// idioma em data.Lang; caso contrário procura name em custom (seção prompts da
+// configuração) e depois em <promptsDir>/<name>.tmpl.
+func RenderPrompt(name string, custom map[string]string, promptsDir string, data PromptData) (string, error) {
+	if data.Lang == "" {
+		data.Lang = "pt"
+	}
+	if data.ImageCount == 0 {
+		data.ImageCount = 1
+	}
+
+	text, err := lookupPrompt(name, custom, promptsDir, data.Lang)
+	if err != nil {
+		return "", err
+	}
+
+	tmpl, err := template.New(name).Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("template de prompt %q inválido: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("erro ao renderizar prompt %q: %w", name, err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func lookupPrompt(name string, custom map[string]string, promptsDir, lang string) (string, error) {
+	if name == "" {
+		text, ok := defaultPrompts[lang]
+		if !ok {
+			return "", fmt.Errorf("idioma não suportado: %s (use pt ou en)", lang)
+		}
+		return text, nil
+	}
+
+	// viper normaliza as chaves para minúsculas
+	if text, ok := custom[strings.ToLower(name)]; ok {
+		return text, nil
+	}
+
+	if promptsDir != "" {
+		data, err := os.ReadFile(filepath.Join(promptsDir, name+".tmpl"))
+		if err == nil {
+			return string(data), nil
+		}
+		if !errors.Is(err, os.ErrNotExist) {
+			return "", err
+		}
+	}
+
+	return "", fmt.Errorf("prompt %q não encontrado na configuração nem em %s", name, promptsDir)
+}
@@ -0,0 +1,70 @@
+package ai
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/ollama"
+	"github.com/guialveess/opencli/internal/openai"
+)
+
+// Provider é um backend de LLM capaz de completar texto, com ou sem imagens,
+// e de gerar embeddings.
+type Provider interface {
+	// Generate envia o prompt e as imagens (podendo ser nenhuma) ao modelo.
+	// Com onToken não nil a resposta é recebida em streaming.
+	Generate(ctx context.Context, prompt string, images [][]byte, onToken func(string)) (string, error)
+	Embed(text string) ([]float64, error)
+}
+
+const (
+	ProviderOllama = "ollama"
+	ProviderOpenAI = "openai"
+)
+
+// NewProvider cria o provider configurado em ai.provider.
+func NewProvider(cfg config.AIConfig) (Provider, error) {
+	switch cfg.Provider {
+	case "", ProviderOllama:
+		model := cfg.Model
+		if model == "" {
+			model = "llava"
+		}
+		client := ollama.NewClient(model)
+		if cfg.BaseURL != "" {
+			client.BaseURL = cfg.BaseURL
+		}
+		if cfg.EmbedModel != "" {
+			client.EmbedModel = cfg.EmbedModel
+		}
+		return client, nil
+	case ProviderOpenAI:
+		client := openai.NewClient(cfg.BaseURL, cfg.APIKey, cfg.Model)
+		if cfg.EmbedModel != "" {
+			client.EmbedModel = cfg.EmbedModel
+		}
+		return client, nil
+	default:
+		return nil, fmt.Errorf("provider de IA desconhecido: %s (use ollama ou openai)", cfg.Provider)
+	}
+}
+
+// EmbedKey identifica o provider e o modelo de embeddings efetivamente usados
+// (o padrão do provider quando ai.embed_model está vazio), para que deixar o
+// padrão explícito na configuração não invalide o índice em cache.
+func EmbedKey(cfg config.AIConfig, p Provider) string {
+	name := cfg.Provider
+	if name == "" {
+		name = ProviderOllama
+	}
+
+	model := cfg.EmbedModel
+	switch client := p.(type) {
+	case *ollama.Client:
+		model = client.EmbedModel
+	case *openai.Client:
+		model = client.EmbedModel
+	}
+	return name + ":" + model
+}
@@ -0,0 +1,75 @@
+package ai
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+// StandupData são as variáveis do prompt de resumo do standup. Activity é a
+// lista de atividades já formatada, uma por linha.
+type StandupData struct {
+	Lang     string
+	User     string
+	Period   string
+	Activity string
+}
+
+var defaultStandupPrompts = map[string]string{
+	"pt": `Você está ajudando {{ .User }} a escrever a atualização diária (standup) para o time.
+Abaixo estão as atividades registradas no OpenProject {{ .Period }}:
+
+{{ .Activity }}
+
+Escreva um resumo curto em português, em primeira pessoa, pronto para colar no chat:
+- "Feito:" com o que avançou ou concluiu, agrupando itens relacionados
+- "Em andamento:" com o que ainda está aberto
+Cite os work packages pelo número (#123). Não invente atividades que não estão na lista.
+Responda apenas com o resumo, sem introdução.`,
+
+	"en": `You are helping {{ .User }} write the daily standup update for the team.
+Below are the activities recorded in OpenProject {{ .Period }}:
+
+{{ .Activity }}
+
+Write a short summary in English, in the first person, ready to paste into chat:
+- "Done:" with what moved forward or was finished, grouping related items
+- "In progress:" with what is still open
+Refer to work packages by number (#123). Do not invent activities that are not in the list.
+Answer only with the summary, without an introduction.`,
+}
+
+// RenderStandupPrompt monta o prompt do resumo. Um prompt "standup" na seção
+// prompts da configuração ou em <promptsDir>/standup.tmpl substitui o padrão.
+func RenderStandupPrompt(custom map[string]string, promptsDir string, data StandupData) (string, error) {
+	if data.Lang == "" {
+		data.Lang = "pt"
+	}
+
+	text, ok := custom["standup"]
+	if !ok && promptsDir != "" {
+		if content, err := os.ReadFile(filepath.Join(promptsDir, "standup.tmpl")); err == nil {
+			text, ok = string(content), true
+		}
+	}
+	if !ok {
+		if text, ok = defaultStandupPrompts[data.Lang]; !ok {
+			return "", fmt.Errorf("idioma não suportado: %s (use pt ou en)", data.Lang)
+		}
+	}
+
+	tmpl, err := template.New("standup").Funcs(templateFuncs).Parse(text)
+	if err != nil {
+		return "", fmt.Errorf("template de prompt \"standup\" inválido: %w", err)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", fmt.Errorf("erro ao renderizar prompt \"standup\": %w", err)
+	}
+
+	return strings.TrimSpace(buf.String()), nil
+}
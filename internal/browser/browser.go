@@ -0,0 +1,79 @@
+package browser
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// ErrNoDisplay indica que não há ambiente gráfico para abrir um navegador
+// (ex: sessão SSH). Nesse caso o chamador deve apenas exibir a URL.
+var ErrNoDisplay = errors.New("nenhum display disponível para abrir o navegador")
+
+// Open abre url no navegador padrão. $BROWSER tem prioridade e pode conter uma
+// lista separada por ":" e o marcador %s, como no xdg-utils.
+func Open(url string) error {
+	if env := os.Getenv("BROWSER"); env != "" {
+		var lastErr error
+		for _, candidate := range strings.Split(env, ":") {
+			if candidate == "" {
+				continue
+			}
+			if lastErr = runBrowser(candidate, url); lastErr == nil {
+				return nil
+			}
+		}
+		return lastErr
+	}
+
+	switch runtime.GOOS {
+	case "darwin":
+		return exec.Command("open", url).Start()
+	case "windows":
+		return exec.Command("rundll32", "url.dll,FileProtocolHandler", url).Start()
+	default:
+		if !hasDisplay() {
+			return ErrNoDisplay
+		}
+		if _, err := exec.LookPath("xdg-open"); err != nil {
+			return ErrNoDisplay
+		}
+		return exec.Command("xdg-open", url).Start()
+	}
+}
+
+// runBrowser executa um navegador de $BROWSER. Com ambiente gráfico ele é
+// iniciado em segundo plano, sem esperar a janela fechar; sem display fica
+// ligado ao terminal, o que permite navegadores em modo texto (lynx, w3m).
+func runBrowser(command, url string) error {
+	var args []string
+	if strings.Contains(command, "%s") {
+		args = strings.Fields(strings.ReplaceAll(command, "%s", url))
+	} else {
+		args = append(strings.Fields(command), url)
+	}
+
+	cmd := exec.Command(args[0], args[1:]...)
+	if hasDisplay() {
+		if err := cmd.Start(); err != nil {
+			return err
+		}
+		return cmd.Process.Release()
+	}
+
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+func hasDisplay() bool {
+	switch runtime.GOOS {
+	case "darwin", "windows":
+		return true
+	default:
+		return os.Getenv("DISPLAY") != "" || os.Getenv("WAYLAND_DISPLAY") != ""
+	}
+}
@@ -2,25 +2,33 @@ package clipboard
 
 import (
 	"fmt"
+	"net/url"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strings"
 )
 
-func GetImageFromClipboard() (string, error) {
+// GetImageFromClipboard obtém a imagem do clipboard. Chame Cleanup na imagem
+// retornada ao terminar de usá-la.
+func GetImageFromClipboard() (*Image, error) {
 	switch runtime.GOOS {
 	case "darwin":
 		return getImageMacOS()
 	case "linux":
 		return getImageLinux()
 	default:
-		return "", fmt.Errorf("sistema operacional não suportado: %s", runtime.GOOS)
+		return nil, fmt.Errorf("sistema operacional não suportado: %s", runtime.GOOS)
 	}
 }
 
-func getImageMacOS() (string, error) {
-	tmpFile := filepath.Join(os.TempDir(), "opcli-clipboard.png")
+func getImageMacOS() (*Image, error) {
+	img, f, err := newTempImage(".png")
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
 
 	script := `
 		use framework "AppKit"
@@ -29,70 +37,226 @@ func getImageMacOS() (string, error) {
 		if imgData is missing value then
 			error "Nenhuma imagem no clipboard"
 		end if
-		set filePath to "` + tmpFile + `"
-		imgData's writeToFile:filePath atomically:true
+		set filePath to "` + img.Path + `"
+		imgData's writeToFile:filePath atomically:false
 		return filePath
 	`
 
 	cmd := exec.Command("osascript", "-l", "AppleScript", "-e", script)
 	output, err := cmd.CombinedOutput()
 	if err != nil {
-		return getImageMacOSFallback()
+		return getImageMacOSFallback(img)
 	}
 
-	if _, err := os.Stat(tmpFile); os.IsNotExist(err) {
-		return "", fmt.Errorf("falha ao salvar imagem: %s", string(output))
+	if info, err := os.Stat(img.Path); err != nil || info.Size() == 0 {
+		img.Cleanup()
+		return nil, fmt.Errorf("falha ao salvar imagem: %s", string(output))
 	}
 
-	return tmpFile, nil
+	os.Chmod(img.Path, 0600)
+	return img, nil
 }
 
-func getImageMacOSFallback() (string, error) {
-	tmpFile := filepath.Join(os.TempDir(), "opcli-clipboard.png")
-
+func getImageMacOSFallback(img *Image) (*Image, error) {
 	if _, err := exec.LookPath("pngpaste"); err == nil {
-		cmd := exec.Command("pngpaste", tmpFile)
+		cmd := exec.Command("pngpaste", img.Path)
 		if err := cmd.Run(); err != nil {
-			return "", fmt.Errorf("nenhuma imagem no clipboard")
+			img.Cleanup()
+			return nil, fmt.Errorf("nenhuma imagem no clipboard")
 		}
-		return tmpFile, nil
+		os.Chmod(img.Path, 0600)
+		return img, nil
 	}
 
-	return "", fmt.Errorf("nenhuma imagem no clipboard (instale pngpaste: brew install pngpaste)")
+	img.Cleanup()
+	return nil, fmt.Errorf("nenhuma imagem no clipboard (instale pngpaste: brew install pngpaste)")
+}
+
+// imageMIMETypes são os formatos de imagem aceitos, em ordem de preferência.
+var imageMIMETypes = []struct {
+	mime string
+	ext  string
+}{
+	{"image/png", ".png"},
+	{"image/jpeg", ".jpg"},
+	{"image/bmp", ".bmp"},
+}
+
+var imageExtensions = map[string]bool{
+	".png": true, ".jpg": true, ".jpeg": true, ".gif": true, ".webp": true, ".bmp": true,
 }
 
-func getImageLinux() (string, error) {
-	tmpFile := filepath.Join(os.TempDir(), "opcli-clipboard.png")
+// linuxClipboard abstrai a ferramenta usada para ler o clipboard (wl-paste, xclip, xsel).
+type linuxClipboard struct {
+	// listTypes retorna os MIME types disponíveis; nil quando a ferramenta não sabe listar
+	listTypes func() ([]string, error)
+	read      func(mime string) ([]byte, error)
+}
+
+func getImageLinux() (*Image, error) {
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		if _, err := exec.LookPath("wl-paste"); err == nil {
+			return readLinuxClipboard(waylandClipboard())
+		}
+	}
 
 	if _, err := exec.LookPath("xclip"); err == nil {
-		cmd := exec.Command("xclip", "-selection", "clipboard", "-t", "image/png", "-o")
-		output, err := cmd.Output()
-		if err != nil || len(output) == 0 {
-			return "", fmt.Errorf("nenhuma imagem no clipboard")
+		return readLinuxClipboard(xclipClipboard())
+	}
+
+	if _, err := exec.LookPath("xsel"); err == nil {
+		return readLinuxClipboard(xselClipboard())
+	}
+
+	if os.Getenv("WAYLAND_DISPLAY") != "" {
+		return nil, fmt.Errorf("instale wl-clipboard para usar clipboard no Wayland")
+	}
+	return nil, fmt.Errorf("instale xclip (X11) ou wl-clipboard (Wayland) para usar clipboard no Linux")
+}
+
+func waylandClipboard() linuxClipboard {
+	return linuxClipboard{
+		listTypes: func() ([]string, error) {
+			output, err := exec.Command("wl-paste", "--list-types").Output()
+			if err != nil {
+				return nil, fmt.Errorf("nenhuma imagem no clipboard")
+			}
+			return splitLines(string(output)), nil
+		},
+		read: func(mime string) ([]byte, error) {
+			return exec.Command("wl-paste", "--no-newline", "--type", mime).Output()
+		},
+	}
+}
+
+func xclipClipboard() linuxClipboard {
+	return linuxClipboard{
+		listTypes: func() ([]string, error) {
+			output, err := exec.Command("xclip", "-selection", "clipboard", "-t", "TARGETS", "-o").Output()
+			if err != nil {
+				return nil, fmt.Errorf("nenhuma imagem no clipboard")
+			}
+			return splitLines(string(output)), nil
+		},
+		read: func(mime string) ([]byte, error) {
+			return exec.Command("xclip", "-selection", "clipboard", "-t", mime, "-o").Output()
+		},
+	}
+}
+
+// xselClipboard só lê texto: serve apenas para caminhos de arquivo copiados.
+func xselClipboard() linuxClipboard {
+	return linuxClipboard{
+		read: func(string) ([]byte, error) {
+			return exec.Command("xsel", "--clipboard", "--output").Output()
+		},
+	}
+}
+
+func readLinuxClipboard(cb linuxClipboard) (*Image, error) {
+	if cb.listTypes == nil {
+		data, err := cb.read("text/plain")
+		if err != nil || len(data) == 0 {
+			return nil, fmt.Errorf("nenhuma imagem no clipboard")
 		}
-		if err := os.WriteFile(tmpFile, output, 0644); err != nil {
-			return "", err
+		return imageFromText(string(data))
+	}
+
+	types, err := cb.listTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	available := make(map[string]bool, len(types))
+	for _, t := range types {
+		available[t] = true
+	}
+
+	for _, it := range imageMIMETypes {
+		if !available[it.mime] {
+			continue
+		}
+		data, err := cb.read(it.mime)
+		if err != nil || len(data) == 0 {
+			return nil, fmt.Errorf("falha ao ler %s do clipboard", it.mime)
 		}
-		return tmpFile, nil
+		return writeTempImage(data, it.ext)
 	}
 
-	if _, err := exec.LookPath("xsel"); err == nil {
-		cmd := exec.Command("xsel", "--clipboard", "--output")
-		output, err := cmd.Output()
-		if err != nil || len(output) == 0 {
-			return "", fmt.Errorf("nenhuma imagem no clipboard")
+	// arquivo copiado em um gerenciador de arquivos (Nautilus, Dolphin, Thunar...)
+	for _, mime := range []string{"text/uri-list", "x-special/gnome-copied-files"} {
+		if !available[mime] {
+			continue
 		}
-		if err := os.WriteFile(tmpFile, output, 0644); err != nil {
-			return "", err
+		data, err := cb.read(mime)
+		if err == nil && len(data) > 0 {
+			return imageFromText(string(data))
 		}
-		return tmpFile, nil
 	}
 
-	return "", fmt.Errorf("instale xclip ou xsel para usar clipboard no Linux")
+	for _, mime := range []string{"text/plain;charset=utf-8", "text/plain", "UTF8_STRING", "STRING"} {
+		if !available[mime] {
+			continue
+		}
+		data, err := cb.read(mime)
+		if err == nil && len(data) > 0 {
+			return imageFromText(string(data))
+		}
+	}
+
+	return nil, fmt.Errorf("nenhuma imagem no clipboard")
+}
+
+func imageFromText(text string) (*Image, error) {
+	path, err := imagePathFromText(text)
+	if err != nil {
+		return nil, err
+	}
+	return &Image{Path: path}, nil
 }
 
-func Cleanup(path string) {
-	if path != "" && filepath.Dir(path) == os.TempDir() {
-		os.Remove(path)
+// imagePathFromText aceita uma lista de URIs (text/uri-list), a lista do GNOME
+// ("copy\nfile://...") ou um caminho simples, e retorna a primeira imagem existente.
+func imagePathFromText(text string) (string, error) {
+	for _, line := range splitLines(text) {
+		if strings.HasPrefix(line, "#") || line == "copy" || line == "cut" {
+			continue
+		}
+
+		path := line
+		if strings.HasPrefix(line, "file://") {
+			u, err := url.Parse(line)
+			if err != nil {
+				continue
+			}
+			path = u.Path
+		}
+
+		if !filepath.IsAbs(path) {
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil || info.IsDir() {
+			continue
+		}
+
+		if !imageExtensions[strings.ToLower(filepath.Ext(path))] {
+			return "", fmt.Errorf("o arquivo copiado não é uma imagem: %s", path)
+		}
+		return path, nil
+	}
+
+	return "", fmt.Errorf("o clipboard contém texto, não uma imagem")
+}
+
+func splitLines(text string) []string {
+	var lines []string
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimSpace(line)
+		if line != "" {
+			lines = append(lines, line)
+		}
 	}
+	return lines
 }
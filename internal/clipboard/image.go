@@ -0,0 +1,142 @@
+package clipboard
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"time"
+)
+
+// Image é uma imagem obtida do clipboard. Quando veio como dados brutos ela é
+// gravada em um arquivo temporário exclusivo (0600), removido por Cleanup ou
+// ao receber SIGINT/SIGTERM. Arquivos copiados do gerenciador de arquivos
+// apontam para o original e nunca são removidos.
+type Image struct {
+	Path string
+	temp bool
+}
+
+var (
+	trackedMu    sync.Mutex
+	tracked      = make(map[string]struct{})
+	watchSignals sync.Once
+)
+
+// newTempImage cria um arquivo temporário exclusivo e o registra para remoção.
+func newTempImage(ext string) (*Image, *os.File, error) {
+	f, err := os.CreateTemp("", "opcli-clipboard-*"+ext)
+	if err != nil {
+		return nil, nil, fmt.Errorf("falha ao criar arquivo temporário: %w", err)
+	}
+
+	img := &Image{Path: f.Name(), temp: true}
+	track(img.Path)
+	return img, f, nil
+}
+
+func writeTempImage(data []byte, ext string) (*Image, error) {
+	img, f, err := newTempImage(ext)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		img.Cleanup()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		img.Cleanup()
+		return nil, err
+	}
+
+	return img, nil
+}
+
+func track(path string) {
+	trackedMu.Lock()
+	tracked[path] = struct{}{}
+	trackedMu.Unlock()
+
+	watchSignals.Do(func() {
+		ch := make(chan os.Signal, 1)
+		signal.Notify(ch, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			sig := <-ch
+			CleanupAll()
+			signal.Stop(ch)
+			// devolve o sinal: outros handlers (ex: cancelamento da análise)
+			// continuam funcionando e, sem eles, o processo termina normalmente
+			if p, err := os.FindProcess(os.Getpid()); err == nil {
+				if p.Signal(sig) != nil {
+					os.Exit(130)
+				}
+			}
+		}()
+	})
+}
+
+// Cleanup remove a imagem se ela for um arquivo temporário da CLI.
+func (img *Image) Cleanup() {
+	if img == nil || !img.temp {
+		return
+	}
+
+	os.Remove(img.Path)
+
+	trackedMu.Lock()
+	delete(tracked, img.Path)
+	trackedMu.Unlock()
+}
+
+// CleanupAll remove todas as imagens temporárias ainda pendentes. Útil antes de
+// os.Exit, que não executa defers.
+func CleanupAll() {
+	trackedMu.Lock()
+	defer trackedMu.Unlock()
+
+	for path := range tracked {
+		os.Remove(path)
+		delete(tracked, path)
+	}
+}
+
+// Archive copia a imagem para dir com um nome baseado na data e hora e
+// retorna o caminho do arquivo arquivado.
+func (img *Image) Archive(dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return "", err
+	}
+
+	src, err := os.Open(img.Path)
+	if err != nil {
+		return "", err
+	}
+	defer src.Close()
+
+	name := fmt.Sprintf("screenshot-%s%s", time.Now().Format("20060102-150405"), filepath.Ext(img.Path))
+	dest := filepath.Join(dir, name)
+
+	dst, err := os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if os.IsExist(err) {
+		dest = filepath.Join(dir, fmt.Sprintf("screenshot-%s%s", time.Now().Format("20060102-150405.000"), filepath.Ext(img.Path)))
+		dst, err = os.OpenFile(dest, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return "", err
+	}
+	if err := dst.Close(); err != nil {
+		return "", err
+	}
+
+	return dest, nil
+}
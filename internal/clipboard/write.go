@@ -0,0 +1,68 @@
+package clipboard
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// WriteText copia text para o clipboard do sistema. Sem uma ferramenta disponível
+// (ex: sessão SSH sem display) usa a sequência OSC 52, que o terminal local
+// interpreta e copia para o clipboard da máquina do usuário.
+func WriteText(text string) error {
+	if tool := writeTool(); tool != nil {
+		cmd := exec.Command(tool[0], tool[1:]...)
+		cmd.Stdin = strings.NewReader(text)
+		if err := cmd.Run(); err == nil {
+			return nil
+		}
+	}
+
+	return writeOSC52(text)
+}
+
+func writeTool() []string {
+	switch runtime.GOOS {
+	case "darwin":
+		if _, err := exec.LookPath("pbcopy"); err == nil {
+			return []string{"pbcopy"}
+		}
+	case "linux":
+		if os.Getenv("WAYLAND_DISPLAY") != "" {
+			if _, err := exec.LookPath("wl-copy"); err == nil {
+				return []string{"wl-copy"}
+			}
+		}
+		if os.Getenv("DISPLAY") != "" {
+			if _, err := exec.LookPath("xclip"); err == nil {
+				return []string{"xclip", "-selection", "clipboard", "-i"}
+			}
+			if _, err := exec.LookPath("xsel"); err == nil {
+				return []string{"xsel", "--clipboard", "--input"}
+			}
+		}
+	}
+	return nil
+}
+
+func writeOSC52(text string) error {
+	seq := fmt.Sprintf("\x1b]52;c;%s\x07", base64.StdEncoding.EncodeToString([]byte(text)))
+
+	// dentro do tmux a sequência precisa ser repassada ao terminal externo
+	if os.Getenv("TMUX") != "" {
+		seq = "\x1bPtmux;\x1b" + strings.ReplaceAll(seq, "\x1b", "\x1b\x1b") + "\x1b\\"
+	}
+
+	var out io.Writer = os.Stderr
+	if tty, err := os.OpenFile("/dev/tty", os.O_WRONLY, 0); err == nil {
+		defer tty.Close()
+		out = tty
+	}
+
+	_, err := io.WriteString(out, seq)
+	return err
+}
@@ -4,30 +4,139 @@ import (
 	"errors"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	BaseURL string `mapstructure:"base_url"`
-	APIKey  string `mapstructure:"api_key"`
-	Project string `mapstructure:"project"`
+	BaseURL   string            `mapstructure:"base_url"`
+	APIKey    string            `mapstructure:"api_key"`
+	Project   string            `mapstructure:"project"`
+	AI        AIConfig          `mapstructure:"ai"`
+	Prompts   map[string]string `mapstructure:"prompts"`
+	Redact    RedactConfig      `mapstructure:"redact"`
+	Clipboard ClipboardConfig   `mapstructure:"clipboard"`
+
+	Notifications NotificationsConfig `mapstructure:"notifications"`
+	Webhook       WebhookConfig       `mapstructure:"webhook"`
+
+	// Presets são filtros nomeados do "wp list --preset", no formato
+	// campo: valor aceito por --filter.
+	Presets map[string]map[string]string `mapstructure:"presets"`
+}
+
+// ClipboardConfig define onde guardar cópias das imagens do clipboard.
+// Com Keep=true toda imagem usada é arquivada em ArchiveDir.
+type ClipboardConfig struct {
+	ArchiveDir string `mapstructure:"archive_dir"`
+	Keep       bool   `mapstructure:"keep"`
+}
+
+// NotificationsConfig controla o "op notifications watch". RateLimit é o
+// máximo de notificações na área de trabalho por minuto (0 não limita).
+type NotificationsConfig struct {
+	Interval  time.Duration    `mapstructure:"interval"`
+	RateLimit int              `mapstructure:"rate_limit"`
+	Mute      NotificationMute `mapstructure:"mute"`
+}
+
+// NotificationMute lista o que não deve gerar notificação: motivos (ex:
+// watched), projetos e usuários (nome ou ID) e work packages (ID).
+type NotificationMute struct {
+	Reasons      []string `mapstructure:"reasons"`
+	Projects     []string `mapstructure:"projects"`
+	Users        []string `mapstructure:"users"`
+	WorkPackages []int    `mapstructure:"work_packages"`
+}
+
+// WebhookConfig configura o "op webhook serve". Secret é o mesmo definido no
+// webhook do OpenProject e valida a assinatura de cada requisição.
+type WebhookConfig struct {
+	Secret  string          `mapstructure:"secret"`
+	Port    int             `mapstructure:"port"`
+	Actions []WebhookAction `mapstructure:"actions"`
+}
+
+// WebhookAction é executada quando um evento casa com Events (ex:
+// work_package:updated, aceita *) e com os campos em When. Com Changed, só
+// dispara se um desses campos mudou desde o último evento do work package.
+// Run é um comando de shell; Notify e Message são templates Go exibidos na
+// área de trabalho e na saída padrão.
+type WebhookAction struct {
+	Name    string            `mapstructure:"name"`
+	Events  []string          `mapstructure:"events"`
+	When    map[string]string `mapstructure:"when"`
+	Changed []string          `mapstructure:"changed"`
+	Run     string            `mapstructure:"run"`
+	Notify  string            `mapstructure:"notify"`
+	Message string            `mapstructure:"message"`
+}
+
+// RedactConfig controla o mascaramento de segredos e dados pessoais nos textos
+// gerados pela IA. Patterns se somam aos detectores embutidos.
+type RedactConfig struct {
+	Disabled bool            `mapstructure:"disabled"`
+	Patterns []RedactPattern `mapstructure:"patterns"`
+}
+
+type RedactPattern struct {
+	Name    string `mapstructure:"name"`
+	Pattern string `mapstructure:"pattern"`
+}
+
+// AIConfig seleciona o backend de IA usado na análise de imagens.
+// Provider pode ser "ollama" (padrão) ou "openai" para servidores compatíveis
+// com /v1/chat/completions (llama.cpp server, LM Studio, vLLM).
+type AIConfig struct {
+	Provider   string `mapstructure:"provider"`
+	BaseURL    string `mapstructure:"base_url"`
+	Model      string `mapstructure:"model"`
+	EmbedModel string `mapstructure:"embed_model"`
+	APIKey     string `mapstructure:"api_key"`
+	Language   string `mapstructure:"language"`
+
+	// MaxImageSize é o maior lado, em pixels, das imagens enviadas ao modelo (0 desativa).
+	MaxImageSize int `mapstructure:"max_image_size"`
+}
+
+// Dir retorna o diretório de configuração (~/.config/opcli).
+func Dir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "opcli"), nil
 }
 
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
 
-	home, err := os.UserHomeDir()
+	dir, err := Dir()
 	if err != nil {
 		return nil, err
 	}
 
-	viper.AddConfigPath(filepath.Join(home, ".config", "opcli"))
+	viper.AddConfigPath(dir)
 
 	viper.BindEnv("base_url", "OPENPROJECT_BASE_URL")
 	viper.BindEnv("project", "OPENPROJECT_PROJECT")
 	viper.BindEnv("api_key", "OPENPROJECT_API_KEY")
+	viper.BindEnv("ai.provider", "OPCLI_AI_PROVIDER")
+	viper.BindEnv("ai.base_url", "OPCLI_AI_BASE_URL")
+	viper.BindEnv("ai.model", "OPCLI_AI_MODEL")
+	viper.BindEnv("ai.api_key", "OPCLI_AI_API_KEY")
+	viper.BindEnv("webhook.secret", "OPCLI_WEBHOOK_SECRET")
+
+	viper.SetDefault("ai.provider", "ollama")
+	viper.SetDefault("ai.language", "pt")
+	viper.SetDefault("ai.max_image_size", 1344)
+	viper.SetDefault("clipboard.archive_dir", "~/.local/share/opcli/screenshots")
+	viper.SetDefault("notifications.interval", "1m")
+	viper.SetDefault("notifications.rate_limit", 5)
+	viper.SetDefault("webhook.port", 8088)
 
 	if err := viper.ReadInConfig(); err != nil {
 		return nil, err
@@ -38,9 +147,26 @@ func Load() (*Config, error) {
 		return nil, err
 	}
 
+	cfg.Clipboard.ArchiveDir, err = expandHome(cfg.Clipboard.ArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+
 	if cfg.BaseURL == "" || cfg.APIKey == "" || cfg.Project == "" {
 		return nil, errors.New("base_url, api_key ausente ou project ausente")
 	}
 
 	return &cfg, nil
 }
+
+func expandHome(path string) (string, error) {
+	if path != "~" && !strings.HasPrefix(path, "~/") {
+		return path, nil
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, strings.TrimPrefix(path, "~")), nil
+}
@@ -0,0 +1,191 @@
+package dedup
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/guialveess/opencli/internal/cache"
+	"github.com/guialveess/opencli/internal/openproject"
+)
+
+const (
+	embeddingThreshold = 0.75
+	tokenThreshold     = 0.30
+)
+
+// Embedder gera o vetor de embedding de um texto (ex: Ollama /api/embeddings).
+type Embedder interface {
+	Embed(text string) ([]float64, error)
+}
+
+type Match struct {
+	WorkPackage openproject.WorkPackage
+	Score       float64
+}
+
+type Result struct {
+	Matches []Match
+	Method  string // "embeddings" ou "tokens"
+}
+
+type indexEntry struct {
+	UpdatedAt string    `json:"updatedAt"`
+	Vector    []float64 `json:"vector"`
+}
+
+type index struct {
+	Model   string             `json:"model"`
+	Entries map[int]indexEntry `json:"entries"`
+}
+
+type Finder struct {
+	Embedder Embedder
+	Model    string
+	Project  string
+	Limit    int
+}
+
+// Find compara subject/description com os work packages informados e retorna
+// os mais parecidos. Usa embeddings com um índice em cache e cai para
+// similaridade de tokens quando o embedder não está disponível.
+func (f *Finder) Find(subject, description string, wps []openproject.WorkPackage) *Result {
+	query := subject + "\n" + description
+
+	if f.Embedder != nil {
+		matches, err := f.findWithEmbeddings(query, wps)
+		if err == nil {
+			return &Result{Matches: f.top(matches), Method: "embeddings"}
+		}
+	}
+
+	return &Result{Matches: f.top(findWithTokens(query, wps)), Method: "tokens"}
+}
+
+func (f *Finder) top(matches []Match) []Match {
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+
+	limit := f.Limit
+	if limit <= 0 {
+		limit = 3
+	}
+	if len(matches) > limit {
+		matches = matches[:limit]
+	}
+	return matches
+}
+
+func (f *Finder) findWithEmbeddings(query string, wps []openproject.WorkPackage) ([]Match, error) {
+	queryVec, err := f.Embedder.Embed(query)
+	if err != nil {
+		return nil, err
+	}
+
+	cacheName := fmt.Sprintf("embeddings-%s.json", f.Project)
+
+	var idx index
+	if err := cache.Load(cacheName, &idx); err != nil || idx.Model != f.Model {
+		idx = index{Model: f.Model}
+	}
+	if idx.Entries == nil {
+		idx.Entries = make(map[int]indexEntry)
+	}
+
+	changed := false
+	var matches []Match
+
+	for _, wp := range wps {
+		entry, ok := idx.Entries[wp.ID]
+		if !ok || entry.UpdatedAt != wp.UpdatedAt {
+			vec, err := f.Embedder.Embed(wp.Subject + "\n" + wp.Description.Raw)
+			if err != nil {
+				// guarda os vetores já calculados para a próxima execução não recomeçar do zero
+				if changed {
+					_ = cache.Save(cacheName, &idx)
+				}
+				return nil, err
+			}
+			entry = indexEntry{UpdatedAt: wp.UpdatedAt, Vector: vec}
+			idx.Entries[wp.ID] = entry
+			changed = true
+		}
+
+		score := cosine(queryVec, entry.Vector)
+		if score >= embeddingThreshold {
+			matches = append(matches, Match{WorkPackage: wp, Score: score})
+		}
+	}
+
+	if changed {
+		// o cache é só uma otimização, falhar ao salvar não invalida o resultado
+		_ = cache.Save(cacheName, &idx)
+	}
+
+	return matches, nil
+}
+
+func findWithTokens(query string, wps []openproject.WorkPackage) []Match {
+	queryTokens := tokenize(query)
+
+	var matches []Match
+	for _, wp := range wps {
+		score := jaccard(queryTokens, tokenize(wp.Subject+" "+wp.Description.Raw))
+		if score >= tokenThreshold {
+			matches = append(matches, Match{WorkPackage: wp, Score: score})
+		}
+	}
+	return matches
+}
+
+func tokenize(text string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsNumber(r)
+	})
+
+	tokens := make(map[string]struct{}, len(words))
+	for _, w := range words {
+		if len([]rune(w)) < 3 {
+			continue
+		}
+		tokens[w] = struct{}{}
+	}
+	return tokens
+}
+
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	intersection := 0
+	for t := range a {
+		if _, ok := b[t]; ok {
+			intersection++
+		}
+	}
+
+	union := len(a) + len(b) - intersection
+	return float64(intersection) / float64(union)
+}
+
+func cosine(a, b []float64) float64 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+
+	var dot, normA, normB float64
+	for i := range a {
+		dot += a[i] * b[i]
+		normA += a[i] * a[i]
+		normB += b[i] * b[i]
+	}
+
+	if normA == 0 || normB == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(normA) * math.Sqrt(normB))
+}
@@ -0,0 +1,54 @@
+package git
+
+import (
+	"fmt"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// CurrentBranch retorna o nome do branch atual do repositório no diretório corrente.
+func CurrentBranch() (string, error) {
+	output, err := exec.Command("git", "rev-parse", "--abbrev-ref", "HEAD").Output()
+	if err != nil {
+		return "", fmt.Errorf("não foi possível obter o branch atual (é um repositório git?)")
+	}
+
+	branch := strings.TrimSpace(string(output))
+	if branch == "HEAD" {
+		return "", fmt.Errorf("HEAD destacado: não há branch atual")
+	}
+	return branch, nil
+}
+
+// branchIDPattern encontra o ID em nomes como feature/1234-login, wp-1234, 1234_fix ou bug/op#1234.
+var branchIDPattern = regexp.MustCompile(`(?:^|[/#_-])(\d+)(?:[-_/]|$)`)
+
+// WorkPackageIDFromBranch extrai o ID do work package do nome do branch.
+func WorkPackageIDFromBranch(branch string) (int, bool) {
+	match := branchIDPattern.FindStringSubmatch(branch)
+	if match == nil {
+		return 0, false
+	}
+
+	id, err := strconv.Atoi(match[1])
+	if err != nil || id == 0 {
+		return 0, false
+	}
+	return id, true
+}
+
+// CurrentWorkPackageID infere o ID do work package a partir do branch atual.
+func CurrentWorkPackageID() (int, error) {
+	branch, err := CurrentBranch()
+	if err != nil {
+		return 0, err
+	}
+
+	id, ok := WorkPackageIDFromBranch(branch)
+	if !ok {
+		return 0, fmt.Errorf("nenhum ID de work package no branch %q", branch)
+	}
+	return id, nil
+}
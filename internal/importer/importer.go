@@ -0,0 +1,254 @@
+package importer
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Fields são os campos padrão que uma coluna pode preencher. Colunas fora
+// desta lista são tratadas como campos customizados (customFieldN ou o nome
+// do campo no OpenProject).
+var Fields = []string{"subject", "description", "type", "priority", "assignee", "parent", "version"}
+
+var aliases = map[string]string{
+	"subject":     "subject",
+	"title":       "subject",
+	"titulo":      "subject",
+	"assunto":     "subject",
+	"summary":     "subject",
+	"resumo":      "subject",
+	"description": "description",
+	"descricao":   "description",
+	"details":     "description",
+	"detalhes":    "description",
+	"body":        "description",
+	"type":        "type",
+	"tipo":        "type",
+	"priority":    "priority",
+	"prioridade":  "priority",
+	"assignee":    "assignee",
+	"assigned to": "assignee",
+	"atribuido":   "assignee",
+	"atribuido a": "assignee",
+	"parent":      "parent",
+	"parent id":   "parent",
+	"pai":         "parent",
+	"version":     "version",
+	"versao":      "version",
+	"sprint":      "version",
+}
+
+// Row é uma linha (CSV) ou item (JSON) do arquivo, indexada pelo nome da coluna.
+type Row struct {
+	Pos    string // posição para mensagens, ex: "linha 3" ou "item 2"
+	Values map[string]string
+}
+
+// ReadFile lê um arquivo .csv (separado por vírgula ou ponto e vírgula, com
+// cabeçalho) ou .json (lista de objetos) e retorna as colunas e as linhas.
+func ReadFile(path string) ([]string, []Row, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".csv":
+		return readCSV(data)
+	case ".json":
+		return readJSON(data)
+	default:
+		return nil, nil, fmt.Errorf("formato não suportado: %s (use .csv ou .json)", filepath.Ext(path))
+	}
+}
+
+func readCSV(data []byte) ([]string, []Row, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.Comma = detectDelimiter(data)
+	r.FieldsPerRecord = -1
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, nil, fmt.Errorf("erro ao ler cabeçalho do CSV: %w", err)
+	}
+	for i := range header {
+		header[i] = strings.TrimSpace(header[i])
+	}
+
+	var rows []Row
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("erro ao ler CSV: %w", err)
+		}
+
+		line, _ := r.FieldPos(0)
+		row := Row{Pos: fmt.Sprintf("linha %d", line), Values: make(map[string]string, len(header))}
+		empty := true
+		for i, column := range header {
+			if i < len(record) {
+				row.Values[column] = strings.TrimSpace(record[i])
+				empty = empty && row.Values[column] == ""
+			}
+		}
+		if !empty {
+			rows = append(rows, row)
+		}
+	}
+
+	return header, rows, nil
+}
+
+// detectDelimiter escolhe entre vírgula e ponto e vírgula (padrão das
+// planilhas em português) contando as ocorrências na primeira linha.
+func detectDelimiter(data []byte) rune {
+	first, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(first, []byte(";")) > bytes.Count(first, []byte(",")) {
+		return ';'
+	}
+	return ','
+}
+
+func readJSON(data []byte) ([]string, []Row, error) {
+	var items []map[string]interface{}
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, nil, fmt.Errorf("o JSON deve ser uma lista de objetos: %w", err)
+	}
+
+	seen := map[string]bool{}
+	var columns []string
+	rows := make([]Row, 0, len(items))
+
+	for i, item := range items {
+		row := Row{Pos: fmt.Sprintf("item %d", i+1), Values: make(map[string]string, len(item))}
+		for key, value := range item {
+			if !seen[key] {
+				seen[key] = true
+				columns = append(columns, key)
+			}
+			row.Values[key] = jsonValue(value)
+		}
+		rows = append(rows, row)
+	}
+
+	sort.Strings(columns)
+	return columns, rows, nil
+}
+
+func jsonValue(v interface{}) string {
+	switch value := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return strings.TrimSpace(value)
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		data, _ := json.Marshal(value)
+		return string(data)
+	}
+}
+
+// ParseMapping interpreta "--map" no formato "Coluna=campo,Outra=campo". O
+// campo pode ser um dos Fields (ou sinônimo), customFieldN ou o nome de um
+// campo customizado.
+func ParseMapping(spec string) (map[string]string, error) {
+	mapping := map[string]string{}
+
+	for _, pair := range strings.Split(spec, ",") {
+		if strings.TrimSpace(pair) == "" {
+			continue
+		}
+		column, field, ok := strings.Cut(pair, "=")
+		column, field = strings.TrimSpace(column), strings.TrimSpace(field)
+		if !ok || column == "" || field == "" {
+			return nil, fmt.Errorf("mapeamento inválido: %q (use Coluna=campo)", pair)
+		}
+		mapping[column] = targetField(field)
+	}
+
+	if len(mapping) == 0 {
+		return nil, fmt.Errorf("mapeamento vazio")
+	}
+	return mapping, nil
+}
+
+// DetectMapping associa cada coluna ao campo padrão correspondente pelo nome
+// do cabeçalho, em português ou inglês. As demais são mantidas com o próprio
+// nome, como candidatas a campos customizados.
+func DetectMapping(columns []string) map[string]string {
+	mapping := make(map[string]string, len(columns))
+	for _, column := range columns {
+		mapping[column] = targetField(column)
+	}
+	return mapping
+}
+
+func targetField(name string) string {
+	if field, ok := aliases[Normalize(name)]; ok {
+		return field
+	}
+	if strings.HasPrefix(strings.ToLower(name), "customfield") {
+		return "customField" + name[len("customfield"):]
+	}
+	return name
+}
+
+// IsStandard informa se field é um dos Fields.
+func IsStandard(field string) bool {
+	for _, f := range Fields {
+		if f == field {
+			return true
+		}
+	}
+	return false
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "ã", "a", "â", "a",
+	"é", "e", "ê", "e",
+	"í", "i",
+	"ó", "o", "ô", "o", "õ", "o",
+	"ú", "u", "ü", "u",
+	"ç", "c",
+	"_", " ", "-", " ",
+)
+
+// Normalize deixa um nome de coluna ou campo em minúsculas, sem acentos e com
+// espaços no lugar de _ e -, para comparações tolerantes.
+func Normalize(name string) string {
+	return strings.Join(strings.Fields(accents.Replace(strings.ToLower(name))), " ")
+}
+
+// Key identifica a linha pelo conteúdo, independente da posição no arquivo.
+// Assim uma importação interrompida pode ser retomada mesmo depois de o
+// arquivo ser corrigido, sem recriar as linhas que já foram importadas.
+func (r Row) Key() string {
+	keys := make([]string, 0, len(r.Values))
+	for k := range r.Values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s=%s\x00", k, r.Values[k])
+	}
+	return hex.EncodeToString(h.Sum(nil)[:8])
+}
@@ -0,0 +1,95 @@
+package notify
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrUnavailable indica que não há como exibir notificações na área de
+// trabalho (ex: sessão SSH ou notify-send não instalado).
+var ErrUnavailable = errors.New("nenhum sistema de notificações disponível (instale notify-send)")
+
+// Send exibe uma notificação na área de trabalho. No Linux usa notify-send ou,
+// sem ele, o serviço org.freedesktop.Notifications via gdbus; no macOS,
+// osascript.
+func Send(title, body string) error {
+	switch runtime.GOOS {
+	case "darwin":
+		script := "display notification " + appleScriptString(body) + " with title " + appleScriptString(title)
+		return exec.Command("osascript", "-e", script).Run()
+	case "linux":
+		if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" && os.Getenv("DISPLAY") == "" && os.Getenv("WAYLAND_DISPLAY") == "" {
+			return ErrUnavailable
+		}
+		if _, err := exec.LookPath("notify-send"); err == nil {
+			return exec.Command("notify-send", "--app-name=opcli", title, body).Run()
+		}
+		if _, err := exec.LookPath("gdbus"); err == nil {
+			return exec.Command("gdbus", "call", "--session",
+				"--dest", "org.freedesktop.Notifications",
+				"--object-path", "/org/freedesktop/Notifications",
+				"--method", "org.freedesktop.Notifications.Notify",
+				"opcli", "0", "", gvariantString(title), gvariantString(body), "[]", "{}", "-1",
+			).Run()
+		}
+	}
+	return ErrUnavailable
+}
+
+func appleScriptString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// gvariantString formata s como literal de string GVariant, o formato dos
+// argumentos do gdbus call.
+func gvariantString(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	return "'" + strings.ReplaceAll(s, "'", `\'`) + "'"
+}
+
+// Limiter limita quantas notificações são exibidas em uma janela de tempo,
+// para que uma rajada de eventos não encha a tela.
+type Limiter struct {
+	max    int
+	window time.Duration
+
+	mu   sync.Mutex
+	sent []time.Time
+}
+
+// NewLimiter permite até max notificações a cada window. max <= 0 não limita.
+func NewLimiter(max int, window time.Duration) *Limiter {
+	return &Limiter{max: max, window: window}
+}
+
+// Allow informa se mais uma notificação pode ser exibida agora e, se sim, a
+// contabiliza.
+func (l *Limiter) Allow() bool {
+	if l.max <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	recent := l.sent[:0]
+	for _, t := range l.sent {
+		if now.Sub(t) < l.window {
+			recent = append(recent, t)
+		}
+	}
+	l.sent = recent
+
+	if len(l.sent) >= l.max {
+		return false
+	}
+	l.sent = append(l.sent, now)
+	return true
+}
@@ -2,9 +2,12 @@ package ollama
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"os"
 	"strings"
@@ -12,9 +15,10 @@ import (
 )
 
 type Client struct {
-	BaseURL string
-	Model   string
-	HTTP    *http.Client
+	BaseURL    string
+	Model      string
+	EmbedModel string
+	HTTP       *http.Client
 }
 
 type GenerateRequest struct {
@@ -27,6 +31,16 @@ type GenerateRequest struct {
 type GenerateResponse struct {
 	Response string `json:"response"`
 	Done     bool   `json:"done"`
+	Error    string `json:"error,omitempty"`
+}
+
+type EmbeddingRequest struct {
+	Model  string `json:"model"`
+	Prompt string `json:"prompt"`
+}
+
+type EmbeddingResponse struct {
+	Embedding []float64 `json:"embedding"`
 }
 
 func NewClient(model string) *Client {
@@ -36,27 +50,29 @@ func NewClient(model string) *Client {
 	}
 
 	return &Client{
-		BaseURL: baseURL,
-		Model:   model,
+		BaseURL:    baseURL,
+		Model:      model,
+		EmbedModel: "nomic-embed-text",
 		HTTP: &http.Client{
 			Timeout: 120 * time.Second,
 		},
 	}
 }
 
-func (c *Client) AnalyzeImage(imagePath, prompt string) (string, error) {
-	imageData, err := os.ReadFile(imagePath)
-	if err != nil {
-		return "", fmt.Errorf("erro ao ler imagem: %w", err)
+// Generate envia o prompt (e as imagens, para modelos de visão) a /api/generate.
+// Se onToken não for nil, a resposta é pedida com stream: true e cada pedaço é
+// repassado a onToken conforme chega. Cancelar ctx aborta a requisição.
+func (c *Client) Generate(ctx context.Context, prompt string, images [][]byte, onToken func(string)) (string, error) {
+	encoded := make([]string, 0, len(images))
+	for _, img := range images {
+		encoded = append(encoded, base64.StdEncoding.EncodeToString(img))
 	}
 
-	base64Image := base64.StdEncoding.EncodeToString(imageData)
-
 	reqBody := GenerateRequest{
 		Model:  c.Model,
 		Prompt: prompt,
-		Images: []string{base64Image},
-		Stream: false,
+		Images: encoded,
+		Stream: onToken != nil,
 	}
 
 	jsonBody, err := json.Marshal(reqBody)
@@ -64,14 +80,25 @@ func (c *Client) AnalyzeImage(imagePath, prompt string) (string, error) {
 		return "", fmt.Errorf("erro ao serializar request: %w", err)
 	}
 
-	req, err := http.NewRequest("POST", c.BaseURL+"/api/generate", bytes.NewReader(jsonBody))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.BaseURL+"/api/generate", bytes.NewReader(jsonBody))
 	if err != nil {
 		return "", fmt.Errorf("erro ao criar request: %w", err)
 	}
 	req.Header.Set("Content-Type", "application/json")
 
-	resp, err := c.HTTP.Do(req)
+	httpClient := c.HTTP
+	if reqBody.Stream {
+		// em streaming o timeout total cortaria respostas longas; o limite fica com o ctx
+		streamClient := *c.HTTP
+		streamClient.Timeout = 0
+		httpClient = &streamClient
+	}
+
+	resp, err := httpClient.Do(req)
 	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
 		return "", fmt.Errorf("erro ao conectar com Ollama: %w", err)
 	}
 	defer resp.Body.Close()
@@ -80,90 +107,82 @@ func (c *Client) AnalyzeImage(imagePath, prompt string) (string, error) {
 		return "", fmt.Errorf("ollama retornou status %d", resp.StatusCode)
 	}
 
-	var result GenerateResponse
-	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
-		return "", fmt.Errorf("erro ao decodificar resposta: %w", err)
+	if !reqBody.Stream {
+		var result GenerateResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return "", fmt.Errorf("erro ao decodificar resposta: %w", err)
+		}
+		return result.Response, nil
 	}
 
-	return result.Response, nil
+	return decodeStream(ctx, resp.Body, onToken)
 }
 
-type ImageAnalysis struct {
-	Title       string
-	Description string
-}
+// decodeStream lê os chunks NDJSON de /api/generate até o chunk com done=true.
+func decodeStream(ctx context.Context, body io.Reader, onToken func(string)) (string, error) {
+	var full strings.Builder
+	decoder := json.NewDecoder(body)
 
-func (c *Client) AnalyzeScreenshot(imagePath string) (*ImageAnalysis, error) {
-	prompt := `Esta é uma captura de tela de um software, terminal, IDE ou navegador.
-Você é um desenvolvedor analisando um bug ou problema técnico.
+	for {
+		var chunk GenerateResponse
+		if err := decoder.Decode(&chunk); err != nil {
+			if ctx.Err() != nil {
+				return full.String(), ctx.Err()
+			}
+			if errors.Is(err, io.EOF) {
+				return full.String(), nil
+			}
+			return full.String(), fmt.Errorf("erro ao decodificar resposta: %w", err)
+		}
 
-Analise a imagem e forneça:
-1. Um título curto (máximo 80 caracteres) descrevendo o problema ou erro
-2. Uma descrição técnica do que você vê: mensagens de erro, stack traces, problemas de UI, etc.
+		if chunk.Error != "" {
+			return full.String(), fmt.Errorf("ollama: %s", chunk.Error)
+		}
 
-Se houver texto de erro visível, transcreva-o exatamente.
-Se for código, identifique a linguagem e o problema.
+		if chunk.Response != "" {
+			full.WriteString(chunk.Response)
+			onToken(chunk.Response)
+		}
 
-Responda em português no formato:
-TITULO: <título técnico do problema>
-DESCRICAO: <descrição técnica detalhada>`
+		if chunk.Done {
+			return full.String(), nil
+		}
+	}
+}
 
-	response, err := c.AnalyzeImage(imagePath, prompt)
+func (c *Client) Embed(text string) ([]float64, error) {
+	jsonBody, err := json.Marshal(EmbeddingRequest{
+		Model:  c.EmbedModel,
+		Prompt: text,
+	})
 	if err != nil {
-		return nil, err
+		return nil, fmt.Errorf("erro ao serializar request: %w", err)
 	}
 
-	if os.Getenv("DEBUG") == "1" {
-		fmt.Fprintf(os.Stderr, "[DEBUG] Resposta do Ollama:\n%s\n---\n", response)
+	req, err := http.NewRequest("POST", c.BaseURL+"/api/embeddings", bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar request: %w", err)
 	}
+	req.Header.Set("Content-Type", "application/json")
 
-	return parseAnalysisResponse(response), nil
-}
-
-func parseAnalysisResponse(response string) *ImageAnalysis {
-	response = strings.TrimSpace(response)
-
-	analysis := &ImageAnalysis{
-		Title:       "Análise de screenshot",
-		Description: response,
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao conectar com Ollama: %w", err)
 	}
+	defer resp.Body.Close()
 
-	if response == "" {
-		analysis.Description = "(O modelo não retornou uma descrição)"
-		return analysis
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ollama retornou status %d", resp.StatusCode)
 	}
 
-	lines := strings.Split(response, "\n")
-	var descStartIndex int = -1
-
-	for i, line := range lines {
-		lineUpper := strings.ToUpper(strings.TrimSpace(line))
-
-		if strings.HasPrefix(lineUpper, "TITULO:") || strings.HasPrefix(lineUpper, "TÍTULO:") || strings.HasPrefix(lineUpper, "TITLE:") {
-			colonIdx := strings.Index(line, ":")
-			if colonIdx != -1 && colonIdx < len(line)-1 {
-				analysis.Title = strings.TrimSpace(line[colonIdx+1:])
-			}
-		}
-
-		if strings.HasPrefix(lineUpper, "DESCRICAO:") || strings.HasPrefix(lineUpper, "DESCRIÇÃO:") || strings.HasPrefix(lineUpper, "DESCRIPTION:") {
-			colonIdx := strings.Index(line, ":")
-			descStartIndex = i
-			if colonIdx != -1 && colonIdx < len(line)-1 {
-				firstPart := strings.TrimSpace(line[colonIdx+1:])
-				if firstPart != "" {
-					lines[i] = firstPart
-				} else {
-					descStartIndex = i + 1
-				}
-			}
-			break
-		}
+	var result EmbeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar resposta: %w", err)
 	}
 
-	if descStartIndex >= 0 && descStartIndex < len(lines) {
-		analysis.Description = strings.TrimSpace(strings.Join(lines[descStartIndex:], "\n"))
+	if len(result.Embedding) == 0 {
+		return nil, fmt.Errorf("modelo %s não retornou embedding", c.EmbedModel)
 	}
 
-	return analysis
+	return result.Embedding, nil
 }
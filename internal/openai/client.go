@@ -0,0 +1,232 @@
+package openai
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Client fala com servidores compatíveis com a API da OpenAI
+// (llama.cpp server, LM Studio, vLLM, etc.).
+type Client struct {
+	BaseURL    string
+	APIKey     string
+	Model      string
+	EmbedModel string
+	HTTP       *http.Client
+}
+
+type ContentPart struct {
+	Type     string    `json:"type"`
+	Text     string    `json:"text,omitempty"`
+	ImageURL *ImageURL `json:"image_url,omitempty"`
+}
+
+type ImageURL struct {
+	URL string `json:"url"`
+}
+
+type Message struct {
+	Role    string      `json:"role"`
+	Content interface{} `json:"content"`
+}
+
+type ChatRequest struct {
+	Model    string    `json:"model,omitempty"`
+	Messages []Message `json:"messages"`
+	Stream   bool      `json:"stream"`
+}
+
+type ChatResponse struct {
+	Choices []struct {
+		Message struct {
+			Content string `json:"content"`
+		} `json:"message"`
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+		FinishReason *string `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+type EmbeddingRequest struct {
+	Model string `json:"model,omitempty"`
+	Input string `json:"input"`
+}
+
+type EmbeddingResponse struct {
+	Data []struct {
+		Embedding []float64 `json:"embedding"`
+	} `json:"data"`
+}
+
+func NewClient(baseURL, apiKey, model string) *Client {
+	if baseURL == "" {
+		baseURL = "http://localhost:8080"
+	}
+
+	return &Client{
+		BaseURL:    strings.TrimSuffix(strings.TrimSuffix(baseURL, "/"), "/v1"),
+		APIKey:     apiKey,
+		Model:      model,
+		EmbedModel: model,
+		HTTP: &http.Client{
+			Timeout: 120 * time.Second,
+		},
+	}
+}
+
+func (c *Client) newRequest(ctx context.Context, path string, body interface{}) (*http.Request, error) {
+	jsonBody, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao serializar request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "POST", c.BaseURL+path, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, fmt.Errorf("erro ao criar request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if c.APIKey != "" {
+		req.Header.Set("Authorization", "Bearer "+c.APIKey)
+	}
+
+	return req, nil
+}
+
+// Generate envia o prompt e as imagens a /v1/chat/completions. Se onToken não
+// for nil a resposta é lida em streaming (server-sent events).
+func (c *Client) Generate(ctx context.Context, prompt string, images [][]byte, onToken func(string)) (string, error) {
+	var content interface{} = prompt
+	if len(images) > 0 {
+		parts := []ContentPart{{Type: "text", Text: prompt}}
+		for _, img := range images {
+			dataURL := fmt.Sprintf("data:%s;base64,%s", http.DetectContentType(img), base64.StdEncoding.EncodeToString(img))
+			parts = append(parts, ContentPart{Type: "image_url", ImageURL: &ImageURL{URL: dataURL}})
+		}
+		content = parts
+	}
+
+	chatReq := ChatRequest{
+		Model:    c.Model,
+		Messages: []Message{{Role: "user", Content: content}},
+		Stream:   onToken != nil,
+	}
+
+	req, err := c.newRequest(ctx, "/v1/chat/completions", chatReq)
+	if err != nil {
+		return "", err
+	}
+
+	httpClient := c.HTTP
+	if chatReq.Stream {
+		streamClient := *c.HTTP
+		streamClient.Timeout = 0
+		httpClient = &streamClient
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() != nil {
+			return "", ctx.Err()
+		}
+		return "", fmt.Errorf("erro ao conectar com %s: %w", c.BaseURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return "", fmt.Errorf("servidor retornou status %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if !chatReq.Stream {
+		var result ChatResponse
+		if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+			return "", fmt.Errorf("erro ao decodificar resposta: %w", err)
+		}
+		if len(result.Choices) == 0 {
+			return "", fmt.Errorf("servidor não retornou nenhuma resposta")
+		}
+		return result.Choices[0].Message.Content, nil
+	}
+
+	return decodeStream(ctx, resp.Body, onToken)
+}
+
+// decodeStream lê as linhas "data: {...}" até "data: [DONE]".
+func decodeStream(ctx context.Context, body io.Reader, onToken func(string)) (string, error) {
+	var full strings.Builder
+	scanner := bufio.NewScanner(body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			return full.String(), nil
+		}
+
+		var chunk ChatResponse
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return full.String(), fmt.Errorf("erro ao decodificar resposta: %w", err)
+		}
+
+		for _, choice := range chunk.Choices {
+			if choice.Delta.Content != "" {
+				full.WriteString(choice.Delta.Content)
+				onToken(choice.Delta.Content)
+			}
+		}
+	}
+
+	if ctx.Err() != nil {
+		return full.String(), ctx.Err()
+	}
+	if err := scanner.Err(); err != nil {
+		return full.String(), fmt.Errorf("erro ao ler resposta: %w", err)
+	}
+
+	return full.String(), nil
+}
+
+func (c *Client) Embed(text string) ([]float64, error) {
+	req, err := c.newRequest(context.Background(), "/v1/embeddings", EmbeddingRequest{
+		Model: c.EmbedModel,
+		Input: text,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("erro ao conectar com %s: %w", c.BaseURL, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("servidor retornou status %d", resp.StatusCode)
+	}
+
+	var result EmbeddingResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, fmt.Errorf("erro ao decodificar resposta: %w", err)
+	}
+
+	if len(result.Data) == 0 || len(result.Data[0].Embedding) == 0 {
+		return nil, fmt.Errorf("servidor não retornou embedding")
+	}
+
+	return result.Data[0].Embedding, nil
+}
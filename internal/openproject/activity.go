@@ -0,0 +1,64 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+)
+
+// Activity é uma entrada do histórico de um work package. Version 1 é a
+// criação; Comment vem preenchido quando houve comentário e Details lista as
+// alterações de campos, já em texto no idioma do usuário da API.
+type Activity struct {
+	ID        int    `json:"id"`
+	Version   int    `json:"version"`
+	CreatedAt string `json:"createdAt"`
+	Comment   struct {
+		Raw string `json:"raw"`
+	} `json:"comment"`
+	Details []struct {
+		Raw string `json:"raw"`
+	} `json:"details"`
+	Links struct {
+		User        Link `json:"user"`
+		WorkPackage Link `json:"workPackage"`
+	} `json:"_links"`
+}
+
+type activityListResponse struct {
+	Embedded struct {
+		Elements []Activity `json:"elements"`
+	} `json:"_embedded"`
+}
+
+func (c *Client) ListActivities(workPackageID int) ([]Activity, error) {
+	path := fmt.Sprintf("/api/v3/work_packages/%d/activities", workPackageID)
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar atividades de #%d (status %d)", workPackageID, resp.StatusCode)
+	}
+
+	var result activityListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+// UpdatedSinceFilter restringe a listagem aos work packages alterados a partir de t.
+func UpdatedSinceFilter(t time.Time) Filter {
+	return Filter{Name: "updatedAt", Operator: "<>d", Values: []string{t.UTC().Format(time.RFC3339), ""}}
+}
@@ -0,0 +1,83 @@
+package openproject
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"os"
+	"path/filepath"
+)
+
+type Attachment struct {
+	ID       int    `json:"id"`
+	FileName string `json:"fileName"`
+	FileSize int64  `json:"fileSize"`
+}
+
+// AddAttachment envia o arquivo em filePath como anexo do work package.
+func (c *Client) AddAttachment(id int, filePath string) (*Attachment, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, err
+	}
+
+	fileName := filepath.Base(filePath)
+
+	var body bytes.Buffer
+	writer := multipart.NewWriter(&body)
+
+	metadata, err := json.Marshal(map[string]string{"fileName": fileName})
+	if err != nil {
+		return nil, err
+	}
+	if err := writer.WriteField("metadata", string(metadata)); err != nil {
+		return nil, err
+	}
+
+	header := make(textproto.MIMEHeader)
+	header.Set("Content-Disposition", fmt.Sprintf(`form-data; name="file"; filename=%q`, fileName))
+	header.Set("Content-Type", http.DetectContentType(data))
+	part, err := writer.CreatePart(header)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := part.Write(data); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/api/v3/work_packages/%d/attachments", id)
+
+	req, err := c.newRequest(http.MethodPost, path)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(&body)
+	req.ContentLength = int64(body.Len())
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		respBody, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("falha ao anexar %s: %s (status %d)", fileName, string(respBody), resp.StatusCode)
+	}
+
+	var attachment Attachment
+	if err := json.NewDecoder(resp.Body).Decode(&attachment); err != nil {
+		return nil, err
+	}
+
+	return &attachment, nil
+}
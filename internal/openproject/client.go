@@ -2,7 +2,9 @@ package openproject
 
 import (
 	"encoding/json"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -24,6 +26,16 @@ func NewClient(baseURL, token, project string) *Client {
 	}
 }
 
+// WorkPackageURL retorna o endereço do work package na interface web.
+func (c *Client) WorkPackageURL(id int) string {
+	return fmt.Sprintf("%s/work_packages/%d", strings.TrimSuffix(c.BaseURL, "/"), id)
+}
+
+// ProjectURL retorna o endereço do projeto configurado na interface web.
+func (c *Client) ProjectURL() string {
+	return fmt.Sprintf("%s/projects/%s", strings.TrimSuffix(c.BaseURL, "/"), c.Project)
+}
+
 func (c *Client) newRequest(method, path string) (*http.Request, error) {
 	req, err := http.NewRequest(method, c.BaseURL+path, nil)
 	if err != nil {
@@ -37,8 +49,9 @@ func (c *Client) newRequest(method, path string) (*http.Request, error) {
 }
 
 type User struct {
-	ID   int    `json:"id"`
-	Name string `json:"name"`
+	ID    int    `json:"id"`
+	Name  string `json:"name"`
+	Login string `json:"login,omitempty"`
 }
 
 func (c *Client) GetCurrentUser() (*User, error) {
@@ -0,0 +1,54 @@
+package openproject
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"time"
+)
+
+var durationPattern = regexp.MustCompile(`^P(?:(\d+(?:\.\d+)?)D)?(?:T(?:(\d+(?:\.\d+)?)H)?(?:(\d+(?:\.\d+)?)M)?(?:(\d+(?:\.\d+)?)S)?)?$`)
+
+// ParseDuration converte durações ISO 8601 usadas pela API (ex: "PT2H30M") em time.Duration.
+func ParseDuration(iso string) (time.Duration, error) {
+	match := durationPattern.FindStringSubmatch(iso)
+	if match == nil || iso == "P" || iso == "PT" {
+		return 0, fmt.Errorf("duração inválida: %s", iso)
+	}
+
+	units := []time.Duration{24 * time.Hour, time.Hour, time.Minute, time.Second}
+
+	var total time.Duration
+	for i, unit := range units {
+		if match[i+1] == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(match[i+1], 64)
+		if err != nil {
+			return 0, err
+		}
+		total += time.Duration(v * float64(unit))
+	}
+
+	return total, nil
+}
+
+// FormatHours formata uma duração ISO 8601 como horas, ex: "PT2H30M" → "2.5h".
+// Retorna a string original se ela não puder ser interpretada.
+func FormatHours(iso string) string {
+	if iso == "" {
+		return ""
+	}
+
+	d, err := ParseDuration(iso)
+	if err != nil {
+		return iso
+	}
+	return Hours(d)
+}
+
+// Hours formata uma duração em horas com até duas casas, ex: 2h30m → "2.5h".
+func Hours(d time.Duration) string {
+	return strconv.FormatFloat(math.Round(d.Hours()*100)/100, 'f', -1, 64) + "h"
+}
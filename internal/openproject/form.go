@@ -0,0 +1,111 @@
+package openproject
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// SchemaField descreve um campo do schema de work package.
+type SchemaField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"` // String, Integer, Date, CustomOption, User...
+	Required bool   `json:"required"`
+	Writable bool   `json:"writable"`
+	Links    struct {
+		// AllowedValues lista as opções de campos do tipo lista (CustomOption)
+		AllowedValues linkList `json:"allowedValues"`
+	} `json:"_links"`
+}
+
+// linkList aceita tanto uma lista de links quanto um link único para o
+// endpoint de valores (usado pela API em campos com muitas opções), que é ignorado.
+type linkList []Link
+
+func (l *linkList) UnmarshalJSON(data []byte) error {
+	var links []Link
+	if err := json.Unmarshal(data, &links); err == nil {
+		*l = links
+	}
+	return nil
+}
+
+// FormResult é a resposta do endpoint de formulário: o schema aplicável ao
+// work package (varia por tipo) e os erros de validação, por campo.
+type FormResult struct {
+	Schema map[string]SchemaField
+	Errors map[string]string
+}
+
+type formResponse struct {
+	Embedded struct {
+		Schema           map[string]json.RawMessage `json:"schema"`
+		ValidationErrors map[string]struct {
+			Message string `json:"message"`
+		} `json:"validationErrors"`
+	} `json:"_embedded"`
+}
+
+// ValidateWorkPackage envia o work package ao endpoint de formulário do
+// projeto, que valida o payload sem criar nada.
+func (c *Client) ValidateWorkPackage(req *CreateWorkPackageRequest) (*FormResult, error) {
+	path := fmt.Sprintf("/api/v3/projects/%s/work_packages/form", c.Project)
+
+	payload, err := c.createPayload(req)
+	if err != nil {
+		return nil, err
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq, err := c.newRequest(http.MethodPost, path)
+	if err != nil {
+		return nil, err
+	}
+
+	httpReq.Body = io.NopCloser(bytes.NewReader(payloadBytes))
+	httpReq.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTP.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("falha ao validar work package: %s (status %d)", apiErrorMessage(body), resp.StatusCode)
+	}
+
+	var form formResponse
+	if err := json.NewDecoder(resp.Body).Decode(&form); err != nil {
+		return nil, err
+	}
+
+	result := &FormResult{
+		Schema: make(map[string]SchemaField, len(form.Embedded.Schema)),
+		Errors: make(map[string]string, len(form.Embedded.ValidationErrors)),
+	}
+
+	for key, raw := range form.Embedded.Schema {
+		if strings.HasPrefix(key, "_") {
+			continue
+		}
+		var field SchemaField
+		if err := json.Unmarshal(raw, &field); err == nil {
+			result.Schema[key] = field
+		}
+	}
+
+	for field, e := range form.Embedded.ValidationErrors {
+		result.Errors[field] = e.Message
+	}
+
+	return result, nil
+}
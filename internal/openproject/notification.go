@@ -0,0 +1,100 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// Notification é um item da central de notificações do OpenProject. Reason
+// diz por que o usuário foi notificado: mentioned, assigned, responsible,
+// watched, commented, created, processed, prioritized, scheduled, dateAlert.
+type Notification struct {
+	ID        int    `json:"id"`
+	Reason    string `json:"reason"`
+	ReadIAN   bool   `json:"readIAN"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+	Links     struct {
+		Actor    Link `json:"actor"`
+		Project  Link `json:"project"`
+		Resource Link `json:"resource"`
+		Activity Link `json:"activity"`
+	} `json:"_links"`
+}
+
+type notificationListResponse struct {
+	Total    int `json:"total"`
+	Embedded struct {
+		Elements []Notification `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// ListNotifications retorna as notificações do usuário, das mais recentes para
+// as mais antigas, de todos os projetos. Com unreadOnly, só as não lidas.
+func (c *Client) ListNotifications(unreadOnly bool, pageSize int) ([]Notification, error) {
+	path := fmt.Sprintf("/api/v3/notifications?pageSize=%d&sortBy=%s", pageSize, url.QueryEscape(`[["id","desc"]]`))
+
+	if unreadOnly {
+		encoded, err := encodeFilters([]Filter{{Name: "readIAN", Operator: "=", Values: []string{"f"}}})
+		if err != nil {
+			return nil, err
+		}
+		path += "&filters=" + encoded
+	}
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar notificações (status %d)", resp.StatusCode)
+	}
+
+	var result notificationListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+// MarkNotificationRead marca a notificação como lida.
+func (c *Client) MarkNotificationRead(id int) error {
+	return c.markRead(fmt.Sprintf("/api/v3/notifications/%d/read_ian", id))
+}
+
+// MarkAllNotificationsRead marca todas as notificações do usuário como lidas.
+func (c *Client) MarkAllNotificationsRead() error {
+	return c.markRead("/api/v3/notifications/read_ian")
+}
+
+func (c *Client) markRead(path string) error {
+	req, err := c.newRequest(http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK, http.StatusNoContent:
+		return nil
+	case http.StatusNotFound:
+		return fmt.Errorf("notificação não encontrada")
+	default:
+		return fmt.Errorf("falha ao marcar como lida (status %d)", resp.StatusCode)
+	}
+}
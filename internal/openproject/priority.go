@@ -0,0 +1,59 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Priority struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type priorityListResponse struct {
+	Embedded struct {
+		Elements []Priority `json:"elements"`
+	} `json:"_embedded"`
+}
+
+func (c *Client) ListPriorities() ([]Priority, error) {
+	req, err := c.newRequest(http.MethodGet, "/api/v3/priorities")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar prioridades (status %d)", resp.StatusCode)
+	}
+
+	var result priorityListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+// FindPriority procura uma prioridade pelo nome, sem diferenciar maiúsculas.
+func (c *Client) FindPriority(name string) (*Priority, error) {
+	priorities, err := c.ListPriorities()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range priorities {
+		if strings.EqualFold(p.Name, name) {
+			return &p, nil
+		}
+	}
+
+	return nil, fmt.Errorf("prioridade %q não existe", name)
+}
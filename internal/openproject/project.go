@@ -0,0 +1,42 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type Project struct {
+	ID         int    `json:"id"`
+	Identifier string `json:"identifier"`
+	Name       string `json:"name"`
+}
+
+// GetProject retorna o projeto configurado, usado quando a API exige o ID
+// numérico em vez do identificador.
+func (c *Client) GetProject() (*Project, error) {
+	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("/api/v3/projects/%s", c.Project))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("projeto %q não encontrado", c.Project)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao obter projeto (status %d)", resp.StatusCode)
+	}
+
+	var project Project
+	if err := json.NewDecoder(resp.Body).Decode(&project); err != nil {
+		return nil, err
+	}
+
+	return &project, nil
+}
@@ -0,0 +1,184 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// Query é uma consulta salva no OpenProject (as visões e boards da interface
+// web), com filtros, colunas e ordenação definidos no servidor.
+type Query struct {
+	ID      int           `json:"id"`
+	Name    string        `json:"name"`
+	Public  bool          `json:"public"`
+	Starred bool          `json:"starred"`
+	Hidden  bool          `json:"hidden"`
+	Filters []QueryFilter `json:"filters"`
+	Links   struct {
+		Project Link   `json:"project"`
+		Columns []Link `json:"columns"`
+		SortBy  []Link `json:"sortBy"`
+	} `json:"_links"`
+}
+
+// QueryFilter é um filtro de uma consulta salva. Os valores vêm como links
+// (status, usuários...) ou, em filtros de texto e data, como valores simples.
+type QueryFilter struct {
+	Name   string        `json:"name"`
+	Values []interface{} `json:"values"`
+	Links  struct {
+		Operator Link   `json:"operator"`
+		Values   []Link `json:"values"`
+	} `json:"_links"`
+}
+
+// String descreve o filtro como na interface web, ex: "Status é Ready for QA".
+func (f QueryFilter) String() string {
+	var values []string
+	for _, v := range f.Links.Values {
+		values = append(values, v.Title)
+	}
+	for _, v := range f.Values {
+		values = append(values, fmt.Sprint(v))
+	}
+
+	parts := []string{f.Name, f.Links.Operator.Title}
+	if len(values) > 0 {
+		parts = append(parts, strings.Join(values, ", "))
+	}
+	return strings.Join(parts, " ")
+}
+
+// Column é o identificador de uma coluna da consulta, ex: "status" ou
+// "customField3", extraído do href /api/v3/queries/columns/<id>.
+func (q *Query) Column(i int) string {
+	return path.Base(q.Links.Columns[i].Href)
+}
+
+// QueryResult são os work packages retornados ao executar uma consulta, já
+// filtrados e ordenados pelo servidor.
+type QueryResult struct {
+	Query        *Query
+	Total        int
+	WorkPackages []WorkPackage
+}
+
+type queryListResponse struct {
+	Embedded struct {
+		Elements []Query `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// ListQueries retorna as consultas salvas visíveis no projeto: as do próprio
+// projeto e as globais, sem as ocultas.
+func (c *Client) ListQueries() ([]Query, error) {
+	project, err := c.GetProject()
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodGet, "/api/v3/queries?pageSize=500")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar consultas (status %d)", resp.StatusCode)
+	}
+
+	var result queryListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	var queries []Query
+	for _, q := range result.Embedded.Elements {
+		if q.Hidden {
+			continue
+		}
+		if id := q.Links.Project.ID(); id == 0 || id == project.ID {
+			queries = append(queries, q)
+		}
+	}
+
+	return queries, nil
+}
+
+// FindQuery procura uma consulta salva pelo ID ou pelo nome, sem diferenciar
+// maiúsculas.
+func (c *Client) FindQuery(query string) (*Query, error) {
+	queries, err := c.ListQueries()
+	if err != nil {
+		return nil, err
+	}
+
+	id, _ := strconv.Atoi(query)
+	for _, q := range queries {
+		if q.ID == id || strings.EqualFold(q.Name, query) {
+			return &q, nil
+		}
+	}
+
+	names := make([]string, 0, len(queries))
+	for _, q := range queries {
+		names = append(names, q.Name)
+	}
+	return nil, fmt.Errorf("consulta %q não existe (disponíveis: %s)", query, strings.Join(names, ", "))
+}
+
+type queryRunResponse struct {
+	Query
+	Embedded struct {
+		Results struct {
+			Total    int `json:"total"`
+			Embedded struct {
+				Elements []WorkPackage `json:"elements"`
+			} `json:"_embedded"`
+		} `json:"results"`
+	} `json:"_embedded"`
+}
+
+// RunQuery executa a consulta salva no servidor, com os filtros e a ordenação
+// dela.
+func (c *Client) RunQuery(id int) (*QueryResult, error) {
+	path := fmt.Sprintf("/api/v3/queries/%d?pageSize=500", id)
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("consulta #%d não encontrada", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao executar consulta (status %d)", resp.StatusCode)
+	}
+
+	var result queryRunResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return &QueryResult{
+		Query:        &result.Query,
+		Total:        result.Embedded.Results.Total,
+		WorkPackages: result.Embedded.Results.Embedded.Elements,
+	}, nil
+}
@@ -0,0 +1,66 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+)
+
+// Relation liga dois work packages. Type descreve a relação do ponto de vista
+// de From (ex: "blocks") e ReverseType, do ponto de vista de To ("blocked").
+type Relation struct {
+	ID          int    `json:"id"`
+	Type        string `json:"type"`
+	ReverseType string `json:"reverseType"`
+	Links       struct {
+		From Link `json:"from"`
+		To   Link `json:"to"`
+	} `json:"_links"`
+}
+
+type relationListResponse struct {
+	Embedded struct {
+		Elements []Relation `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// ListRelations retorna, em uma única requisição, as relações em que algum
+// dos work packages informados está envolvido.
+func (c *Client) ListRelations(ids []int) ([]Relation, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	values := make([]string, len(ids))
+	for i, id := range ids {
+		values[i] = strconv.Itoa(id)
+	}
+
+	encoded, err := encodeFilters([]Filter{{Name: "involved", Operator: "=", Values: values}})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodGet, "/api/v3/relations?pageSize=1000&filters="+encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar relações (status %d)", resp.StatusCode)
+	}
+
+	var result relationListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
@@ -0,0 +1,53 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type schemaField struct {
+	Name string `json:"name"`
+}
+
+// GetCustomFieldLabels busca o schema do work package (href em _links.schema)
+// e retorna o nome de cada customFieldN, ex: customField3 → "Cliente".
+func (c *Client) GetCustomFieldLabels(schemaHref string) (map[string]string, error) {
+	if schemaHref == "" {
+		return nil, fmt.Errorf("work package sem schema")
+	}
+
+	req, err := c.newRequest(http.MethodGet, schemaHref)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao obter schema (status %d)", resp.StatusCode)
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.NewDecoder(resp.Body).Decode(&raw); err != nil {
+		return nil, err
+	}
+
+	labels := make(map[string]string)
+	for key, value := range raw {
+		if !strings.HasPrefix(key, "customField") {
+			continue
+		}
+		var field schemaField
+		if err := json.Unmarshal(value, &field); err == nil && field.Name != "" {
+			labels[key] = field.Name
+		}
+	}
+
+	return labels, nil
+}
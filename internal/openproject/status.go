@@ -1 +1,64 @@
 package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Status struct {
+	ID       int    `json:"id"`
+	Name     string `json:"name"`
+	IsClosed bool   `json:"isClosed"`
+}
+
+type statusListResponse struct {
+	Embedded struct {
+		Elements []Status `json:"elements"`
+	} `json:"_embedded"`
+}
+
+func (c *Client) ListStatuses() ([]Status, error) {
+	req, err := c.newRequest(http.MethodGet, "/api/v3/statuses")
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar status (status %d)", resp.StatusCode)
+	}
+
+	var result statusListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+// FindStatus procura um status pelo nome, sem diferenciar maiúsculas.
+func (c *Client) FindStatus(name string) (*Status, error) {
+	statuses, err := c.ListStatuses()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, s := range statuses {
+		if strings.EqualFold(s.Name, name) {
+			return &s, nil
+		}
+	}
+
+	names := make([]string, 0, len(statuses))
+	for _, s := range statuses {
+		names = append(names, s.Name)
+	}
+	return nil, fmt.Errorf("status %q não existe (disponíveis: %s)", name, strings.Join(names, ", "))
+}
@@ -0,0 +1,64 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+type TimeEntry struct {
+	ID      int    `json:"id"`
+	Hours   string `json:"hours"` // duração ISO 8601, ex: PT2H30M
+	SpentOn string `json:"spentOn"`
+	Comment struct {
+		Raw string `json:"raw"`
+	} `json:"comment"`
+	Links struct {
+		WorkPackage Link `json:"workPackage"`
+		User        Link `json:"user"`
+		Activity    Link `json:"activity"`
+		Project     Link `json:"project"`
+	} `json:"_links"`
+}
+
+type timeEntryListResponse struct {
+	Embedded struct {
+		Elements []TimeEntry `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// ListTimeEntries retorna os lançamentos de horas que atendem aos filtros
+// (ex: user, project, spent_on).
+func (c *Client) ListTimeEntries(filters ...Filter) ([]TimeEntry, error) {
+	path := "/api/v3/time_entries?pageSize=500"
+
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return nil, err
+		}
+		path += "&filters=" + encoded
+	}
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar horas (status %d)", resp.StatusCode)
+	}
+
+	var result timeEntryListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
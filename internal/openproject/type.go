@@ -0,0 +1,62 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type Type struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type typeListResponse struct {
+	Embedded struct {
+		Elements []Type `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// ListTypes retorna os tipos (Task, Bug, Feature...) habilitados no projeto.
+func (c *Client) ListTypes() ([]Type, error) {
+	path := fmt.Sprintf("/api/v3/projects/%s/types", c.Project)
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar tipos (status %d)", resp.StatusCode)
+	}
+
+	var result typeListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+// FindType procura um tipo do projeto pelo nome, sem diferenciar maiúsculas.
+func (c *Client) FindType(name string) (*Type, error) {
+	types, err := c.ListTypes()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range types {
+		if strings.EqualFold(t.Name, name) {
+			return &t, nil
+		}
+	}
+
+	return nil, fmt.Errorf("tipo %q não existe no projeto", name)
+}
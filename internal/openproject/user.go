@@ -0,0 +1,101 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+type principalListResponse struct {
+	Embedded struct {
+		Elements []User `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// GetUser busca o usuário pelo ID.
+func (c *Client) GetUser(id int) (*User, error) {
+	req, err := c.newRequest(http.MethodGet, fmt.Sprintf("/api/v3/users/%d", id))
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, fmt.Errorf("usuário #%d não encontrado", id)
+	default:
+		return nil, fmt.Errorf("falha ao buscar usuário #%d (status %d)", id, resp.StatusCode)
+	}
+
+	var user User
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return nil, err
+	}
+
+	return &user, nil
+}
+
+// FindUser resolve um usuário a partir de "me", de um ID numérico ou de parte
+// do nome/login. Retorna erro se a busca for ambígua.
+func (c *Client) FindUser(query string) (*User, error) {
+	if strings.EqualFold(query, "me") {
+		return c.GetCurrentUser()
+	}
+
+	if id, err := strconv.Atoi(query); err == nil {
+		return c.GetUser(id)
+	}
+
+	encoded, err := encodeFilters([]Filter{
+		{Name: "type", Operator: "=", Values: []string{"User"}},
+		{Name: "any_name_attribute", Operator: "~", Values: []string{query}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodGet, "/api/v3/principals?pageSize=20&filters="+encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao buscar usuário %q (status %d)", query, resp.StatusCode)
+	}
+
+	var result principalListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	users := result.Embedded.Elements
+	switch len(users) {
+	case 0:
+		return nil, fmt.Errorf("usuário %q não encontrado", query)
+	case 1:
+		return &users[0], nil
+	}
+
+	names := make([]string, 0, len(users))
+	for _, u := range users {
+		if strings.EqualFold(u.Name, query) || strings.EqualFold(u.Login, query) {
+			return &u, nil
+		}
+		names = append(names, u.Name)
+	}
+	return nil, fmt.Errorf("usuário %q é ambíguo: %s", query, strings.Join(names, ", "))
+}
@@ -0,0 +1,114 @@
+package openproject
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// Version é uma versão/sprint do OpenProject. Status é "open", "locked" ou "closed".
+type Version struct {
+	ID          int    `json:"id"`
+	Name        string `json:"name"`
+	Status      string `json:"status"`
+	StartDate   string `json:"startDate,omitempty"`
+	EndDate     string `json:"endDate,omitempty"`
+	Description struct {
+		Raw string `json:"raw"`
+	} `json:"description"`
+}
+
+type versionListResponse struct {
+	Embedded struct {
+		Elements []Version `json:"elements"`
+	} `json:"_embedded"`
+}
+
+// ListVersions retorna as versões disponíveis no projeto, incluindo as
+// compartilhadas por outros projetos.
+func (c *Client) ListVersions() ([]Version, error) {
+	path := fmt.Sprintf("/api/v3/projects/%s/versions", c.Project)
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar versões (status %d)", resp.StatusCode)
+	}
+
+	var result versionListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+func (c *Client) GetVersion(id int) (*Version, error) {
+	path := fmt.Sprintf("/api/v3/versions/%d", id)
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("versão #%d não encontrada", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao obter versão (status %d)", resp.StatusCode)
+	}
+
+	var v Version
+	if err := json.NewDecoder(resp.Body).Decode(&v); err != nil {
+		return nil, err
+	}
+
+	return &v, nil
+}
+
+// FindVersion procura uma versão do projeto pelo ID ou pelo nome, sem
+// diferenciar maiúsculas.
+func (c *Client) FindVersion(query string) (*Version, error) {
+	if id, err := strconv.Atoi(query); err == nil {
+		return c.GetVersion(id)
+	}
+
+	versions, err := c.ListVersions()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, v := range versions {
+		if strings.EqualFold(v.Name, query) {
+			return &v, nil
+		}
+	}
+
+	names := make([]string, 0, len(versions))
+	for _, v := range versions {
+		names = append(names, v.Name)
+	}
+	return nil, fmt.Errorf("versão %q não existe (disponíveis: %s)", query, strings.Join(names, ", "))
+}
+
+// VersionFilter restringe a listagem aos work packages da versão informada.
+func VersionFilter(id int) Filter {
+	return Filter{Name: "version", Operator: "=", Values: []string{strconv.Itoa(id)}}
+}
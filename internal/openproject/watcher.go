@@ -0,0 +1,101 @@
+package openproject
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+type watcherListResponse struct {
+	Embedded struct {
+		Elements []User `json:"elements"`
+	} `json:"_embedded"`
+}
+
+func (c *Client) ListWatchers(id int) ([]User, error) {
+	path := fmt.Sprintf("/api/v3/work_packages/%d/watchers", id)
+
+	req, err := c.newRequest(http.MethodGet, path)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, fmt.Errorf("work package #%d não encontrado", id)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar observadores do work package #%d (status %d)", id, resp.StatusCode)
+	}
+
+	var result watcherListResponse
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return nil, err
+	}
+
+	return result.Embedded.Elements, nil
+}
+
+func (c *Client) AddWatcher(id int, userID int) error {
+	path := fmt.Sprintf("/api/v3/work_packages/%d/watchers", id)
+
+	payload := map[string]interface{}{
+		"user": map[string]string{
+			"href": fmt.Sprintf("/api/v3/users/%d", userID),
+		},
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(payloadBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("falha ao adicionar observador ao work package #%d: %s (status %d)", id, string(body), resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (c *Client) RemoveWatcher(id int, userID int) error {
+	path := fmt.Sprintf("/api/v3/work_packages/%d/watchers/%d", id, userID)
+
+	req, err := c.newRequest(http.MethodDelete, path)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("falha ao remover observador do work package #%d (status %d)", id, resp.StatusCode)
+	}
+
+	return nil
+}
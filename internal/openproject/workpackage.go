@@ -3,9 +3,14 @@ package openproject
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
 )
 
 type WorkPackageListResponse struct {
@@ -21,6 +26,19 @@ type WorkPackageListResponse struct {
 	} `json:"_links"`
 }
 
+// Link é uma referência HAL (_links) para outro recurso da API.
+type Link struct {
+	Href  string `json:"href,omitempty"`
+	Title string `json:"title,omitempty"`
+}
+
+// ID extrai o ID numérico do final do href, ex: /api/v3/users/5 → 5.
+// Retorna 0 se o link estiver vazio.
+func (l Link) ID() int {
+	id, _ := strconv.Atoi(path.Base(l.Href))
+	return id
+}
+
 type WorkPackage struct {
 	ID          int    `json:"id"`
 	LockVersion int    `json:"lockVersion"`
@@ -28,22 +46,106 @@ type WorkPackage struct {
 	Description struct {
 		Raw string `json:"raw"`
 	} `json:"description"`
-	CreatedAt string `json:"createdAt"`
-	UpdatedAt string `json:"updatedAt"`
-	Links     struct {
-		Status struct {
-			Title string `json:"title"`
-		} `json:"status"`
-		Type struct {
-			Title string `json:"title"`
-		} `json:"type"`
-		Priority struct {
-			Title string `json:"title"`
-		} `json:"priority"`
-		Assignee struct {
-			Title string `json:"title"`
-		} `json:"assignee"`
+	CreatedAt      string `json:"createdAt"`
+	UpdatedAt      string `json:"updatedAt"`
+	StartDate      string `json:"startDate,omitempty"`
+	DueDate        string `json:"dueDate,omitempty"`
+	EstimatedTime  string `json:"estimatedTime,omitempty"`
+	RemainingTime  string `json:"remainingTime,omitempty"`
+	SpentTime      string `json:"spentTime,omitempty"`
+	PercentageDone *int   `json:"percentageDone,omitempty"`
+	StoryPoints    *int   `json:"storyPoints,omitempty"`
+	Links          struct {
+		Status      Link `json:"status"`
+		Type        Link `json:"type"`
+		Priority    Link `json:"priority"`
+		Assignee    Link `json:"assignee"`
+		Author      Link `json:"author"`
+		Responsible Link `json:"responsible"`
+		Version     Link `json:"version"`
+		Category    Link `json:"category"`
+		Project     Link `json:"project"`
+		Schema      Link `json:"schema"`
 	} `json:"_links"`
+
+	// CustomFields guarda os valores de customFieldN já formatados como texto,
+	// vindos tanto dos atributos quanto dos _links (campos do tipo lista/usuário).
+	CustomFields map[string]string `json:"customFields,omitempty"`
+}
+
+func (wp *WorkPackage) UnmarshalJSON(data []byte) error {
+	type plain WorkPackage
+	if err := json.Unmarshal(data, (*plain)(wp)); err != nil {
+		return err
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+
+	var links map[string]json.RawMessage
+	if l, ok := raw["_links"]; ok {
+		json.Unmarshal(l, &links)
+	}
+
+	for _, source := range []map[string]json.RawMessage{raw, links} {
+		for key, value := range source {
+			if !strings.HasPrefix(key, "customField") {
+				continue
+			}
+			if text := formatCustomValue(value); text != "" {
+				if wp.CustomFields == nil {
+					wp.CustomFields = make(map[string]string)
+				}
+				wp.CustomFields[key] = text
+			}
+		}
+	}
+
+	return nil
+}
+
+// formatCustomValue converte o valor de um campo customizado em texto:
+// strings, números, booleanos, texto formatado ({raw}) e links ({title} ou listas).
+func formatCustomValue(value json.RawMessage) string {
+	var v interface{}
+	if err := json.Unmarshal(value, &v); err != nil {
+		return ""
+	}
+
+	switch val := v.(type) {
+	case nil:
+		return ""
+	case string:
+		return val
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case bool:
+		if val {
+			return "Sim"
+		}
+		return "Não"
+	case map[string]interface{}:
+		if raw, ok := val["raw"].(string); ok {
+			return raw
+		}
+		if title, ok := val["title"].(string); ok {
+			return title
+		}
+	case []interface{}:
+		var titles []string
+		for _, item := range val {
+			if m, ok := item.(map[string]interface{}); ok {
+				if title, ok := m["title"].(string); ok {
+					titles = append(titles, title)
+				}
+			}
+		}
+		return strings.Join(titles, ", ")
+	}
+
+	return ""
 }
 
 type WorkPackagePage struct {
@@ -55,9 +157,9 @@ type WorkPackagePage struct {
 	HasNextPage bool
 }
 
-func (c *Client) ListWorkPackages(page, pageSize int) (*WorkPackagePage, error) {
+func (c *Client) ListWorkPackages(page, pageSize int, filters ...Filter) (*WorkPackagePage, error) {
 
-	all, err := c.ListAllWorkPackages()
+	all, err := c.ListAllWorkPackages(filters...)
 	if err != nil {
 		return nil, err
 	}
@@ -84,10 +186,85 @@ func (c *Client) ListWorkPackages(page, pageSize int) (*WorkPackagePage, error)
 	}, nil
 }
 
-func (c *Client) ListAllWorkPackages() ([]WorkPackage, error) {
+// Filter representa um filtro da API do OpenProject, ex: status "o" (abertos).
+type Filter struct {
+	Name     string
+	Operator string
+	Values   []string
+}
+
+// OpenStatusFilter restringe a listagem aos work packages com status aberto.
+func OpenStatusFilter() Filter {
+	return Filter{Name: "status", Operator: "o", Values: []string{}}
+}
+
+// AnyStatusFilter inclui work packages fechados. Sem filtro de status a API
+// retorna apenas os abertos.
+func AnyStatusFilter() Filter {
+	return Filter{Name: "status", Operator: "*", Values: []string{}}
+}
+
+func encodeFilters(filters []Filter) (string, error) {
+	encoded := make([]map[string]interface{}, 0, len(filters))
+	for _, f := range filters {
+		values := f.Values
+		if values == nil {
+			values = []string{}
+		}
+		encoded = append(encoded, map[string]interface{}{
+			f.Name: map[string]interface{}{
+				"operator": f.Operator,
+				"values":   values,
+			},
+		})
+	}
+
+	data, err := json.Marshal(encoded)
+	if err != nil {
+		return "", err
+	}
+	return url.QueryEscape(string(data)), nil
+}
+
+// SearchFilter busca o texto no título, na descrição e nos comentários.
+func SearchFilter(query string) Filter {
+	return Filter{Name: "search", Operator: "**", Values: []string{query}}
+}
+
+// ListAllWorkPackages lista os work packages do projeto do client ou, se
+// Project estiver vazio, de todos os projetos visíveis para o usuário. Busca
+// página por página até chegar ao total informado pela API.
+func (c *Client) ListAllWorkPackages(filters ...Filter) ([]WorkPackage, error) {
+	const pageSize = 500
 
-	path := fmt.Sprintf("/api/v3/projects/%s/work_packages?pageSize=500", c.Project)
+	path := fmt.Sprintf("/api/v3/projects/%s/work_packages?pageSize=%d", c.Project, pageSize)
+	if c.Project == "" {
+		path = fmt.Sprintf("/api/v3/work_packages?pageSize=%d", pageSize)
+	}
 
+	if len(filters) > 0 {
+		encoded, err := encodeFilters(filters)
+		if err != nil {
+			return nil, err
+		}
+		path += "&filters=" + encoded
+	}
+
+	var all []WorkPackage
+	for offset := 1; ; offset++ {
+		result, err := c.listWorkPackagesPage(fmt.Sprintf("%s&offset=%d", path, offset))
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, result.Embedded.Elements...)
+		if len(result.Embedded.Elements) == 0 || len(all) >= result.Total {
+			return all, nil
+		}
+	}
+}
+
+func (c *Client) listWorkPackagesPage(path string) (*WorkPackageListResponse, error) {
 	req, err := c.newRequest(http.MethodGet, path)
 	if err != nil {
 		return nil, err
@@ -99,12 +276,19 @@ func (c *Client) ListAllWorkPackages() ([]WorkPackage, error) {
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotFound && c.Project != "" {
+		return nil, fmt.Errorf("projeto %q não encontrado", c.Project)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("falha ao listar work packages (status %d)", resp.StatusCode)
+	}
+
 	var result WorkPackageListResponse
 	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
 		return nil, err
 	}
 
-	return result.Embedded.Elements, nil
+	return &result, nil
 }
 
 func (c *Client) GetWorkPackage(id int) (*WorkPackage, error) {
@@ -137,6 +321,16 @@ type CreateWorkPackageRequest struct {
 	Subject     string
 	Description string
 	Type        string // opcional: Task, Bug, Feature, etc.
+	TypeID      int    // opcional: usado no lugar de Type quando já resolvido
+	VersionID   int    // opcional: versão/sprint
+	PriorityID  int    // opcional
+	AssigneeID  int    // opcional
+	ParentID    int    // opcional: work package pai
+
+	// CustomFields guarda valores de customFieldN já convertidos para o tipo do
+	// campo; CustomLinks, os hrefs de campos do tipo lista, usuário ou versão.
+	CustomFields map[string]interface{}
+	CustomLinks  map[string]string
 }
 
 type CreateWorkPackageResponse struct {
@@ -144,9 +338,8 @@ type CreateWorkPackageResponse struct {
 	Subject string `json:"subject"`
 }
 
-func (c *Client) CreateWorkPackage(req *CreateWorkPackageRequest) (*CreateWorkPackageResponse, error) {
-	path := fmt.Sprintf("/api/v3/projects/%s/work_packages", c.Project)
-
+// createPayload monta o corpo usado tanto na criação quanto na validação (form).
+func (c *Client) createPayload(req *CreateWorkPackageRequest) (map[string]interface{}, error) {
 	payload := map[string]interface{}{
 		"subject": req.Subject,
 		"description": map[string]string{
@@ -155,12 +348,54 @@ func (c *Client) CreateWorkPackage(req *CreateWorkPackageRequest) (*CreateWorkPa
 		},
 	}
 
-	if req.Type != "" {
-		payload["_links"] = map[string]interface{}{
-			"type": map[string]string{
-				"href": fmt.Sprintf("/api/v3/types?name=%s", req.Type),
-			},
+	for key, value := range req.CustomFields {
+		payload[key] = value
+	}
+
+	links := map[string]interface{}{}
+	addLink := func(rel, href string) {
+		links[rel] = map[string]string{"href": href}
+	}
+
+	switch {
+	case req.TypeID != 0:
+		addLink("type", fmt.Sprintf("/api/v3/types/%d", req.TypeID))
+	case req.Type != "":
+		t, err := c.FindType(req.Type)
+		if err != nil {
+			return nil, err
 		}
+		addLink("type", fmt.Sprintf("/api/v3/types/%d", t.ID))
+	}
+	if req.VersionID != 0 {
+		addLink("version", fmt.Sprintf("/api/v3/versions/%d", req.VersionID))
+	}
+	if req.PriorityID != 0 {
+		addLink("priority", fmt.Sprintf("/api/v3/priorities/%d", req.PriorityID))
+	}
+	if req.AssigneeID != 0 {
+		addLink("assignee", fmt.Sprintf("/api/v3/users/%d", req.AssigneeID))
+	}
+	if req.ParentID != 0 {
+		addLink("parent", fmt.Sprintf("/api/v3/work_packages/%d", req.ParentID))
+	}
+	for rel, href := range req.CustomLinks {
+		addLink(rel, href)
+	}
+
+	if len(links) > 0 {
+		payload["_links"] = links
+	}
+
+	return payload, nil
+}
+
+func (c *Client) CreateWorkPackage(req *CreateWorkPackageRequest) (*CreateWorkPackageResponse, error) {
+	path := fmt.Sprintf("/api/v3/projects/%s/work_packages", c.Project)
+
+	payload, err := c.createPayload(req)
+	if err != nil {
+		return nil, err
 	}
 
 	payloadBytes, err := json.Marshal(payload)
@@ -184,7 +419,7 @@ func (c *Client) CreateWorkPackage(req *CreateWorkPackageRequest) (*CreateWorkPa
 
 	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
 		body, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("falha ao criar work package: %s (status %d)", string(body), resp.StatusCode)
+		return nil, fmt.Errorf("falha ao criar work package: %s (status %d)", apiErrorMessage(body), resp.StatusCode)
 	}
 
 	var result CreateWorkPackageResponse
@@ -195,6 +430,91 @@ func (c *Client) CreateWorkPackage(req *CreateWorkPackageRequest) (*CreateWorkPa
 	return &result, nil
 }
 
+// ErrConflict indica que o work package foi alterado entre a leitura e a
+// atualização (lockVersion desatualizado).
+var ErrConflict = errors.New("work package alterado por outra pessoa")
+
+// WorkPackagePatch descreve uma alteração parcial. Subject vazio mantém o atual;
+// em Links cada relação (status, assignee, version...) aponta para um href e
+// href vazio limpa o campo.
+type WorkPackagePatch struct {
+	Subject string
+	Links   map[string]string
+}
+
+func (p *WorkPackagePatch) IsEmpty() bool {
+	return p.Subject == "" && len(p.Links) == 0
+}
+
+// UpdateWorkPackage aplica patch ao work package usando lockVersion para detectar
+// edições concorrentes; nesse caso retorna um erro que satisfaz errors.Is(err, ErrConflict).
+func (c *Client) UpdateWorkPackage(id, lockVersion int, patch *WorkPackagePatch) (*WorkPackage, error) {
+	path := fmt.Sprintf("/api/v3/work_packages/%d", id)
+
+	payload := map[string]interface{}{
+		"lockVersion": lockVersion,
+	}
+	if patch.Subject != "" {
+		payload["subject"] = patch.Subject
+	}
+	if len(patch.Links) > 0 {
+		links := make(map[string]interface{}, len(patch.Links))
+		for rel, href := range patch.Links {
+			if href == "" {
+				links[rel] = map[string]interface{}{"href": nil}
+			} else {
+				links[rel] = map[string]string{"href": href}
+			}
+		}
+		payload["_links"] = links
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.newRequest(http.MethodPatch, path)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(payloadBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusConflict {
+		return nil, fmt.Errorf("work package #%d: %w", id, ErrConflict)
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("falha ao atualizar work package #%d: %s (status %d)", id, apiErrorMessage(body), resp.StatusCode)
+	}
+
+	var wp WorkPackage
+	if err := json.NewDecoder(resp.Body).Decode(&wp); err != nil {
+		return nil, err
+	}
+
+	return &wp, nil
+}
+
+// apiErrorMessage extrai "message" do corpo de erro da API, ou devolve o corpo inteiro.
+func apiErrorMessage(body []byte) string {
+	var apiErr struct {
+		Message string `json:"message"`
+	}
+	if err := json.Unmarshal(body, &apiErr); err == nil && apiErr.Message != "" {
+		return apiErr.Message
+	}
+	return string(body)
+}
+
 func (c *Client) AssignTaskForMe(id int, assigneeID int) error {
 	wp, err := c.GetWorkPackage(id)
 	if err != nil {
@@ -237,3 +557,39 @@ func (c *Client) AssignTaskForMe(id int, assigneeID int) error {
 
 	return nil
 }
+
+func (c *Client) AddComment(id int, comment string) error {
+	path := fmt.Sprintf("/api/v3/work_packages/%d/activities", id)
+
+	payload := map[string]interface{}{
+		"comment": map[string]string{
+			"raw": comment,
+		},
+	}
+
+	payloadBytes, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := c.newRequest(http.MethodPost, path)
+	if err != nil {
+		return err
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(payloadBytes))
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.HTTP.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("falha ao comentar no work package #%d: %s (status %d)", id, string(body), resp.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package redact
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// Rule é um detector de dado sensível. Quando Group > 0 apenas aquele grupo
+// da expressão é mascarado (ex: o valor em "password=xyz", mantendo a chave).
+type Rule struct {
+	Name    string
+	Pattern *regexp.Regexp
+	Group   int
+}
+
+type Finding struct {
+	Rule  string
+	Value string
+}
+
+// Preview retorna o valor mascarado para exibição, sem revelar o segredo.
+func (f Finding) Preview() string {
+	runes := []rune(f.Value)
+	if len(runes) <= 8 {
+		return strings.Repeat("*", len(runes))
+	}
+	return fmt.Sprintf("%s… (%d caracteres)", string(runes[:2]), len(runes))
+}
+
+var builtinRules = []Rule{
+	{Name: "jwt", Pattern: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]{5,}\.[A-Za-z0-9_-]{5,}`)},
+	{Name: "aws-key", Pattern: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{Name: "aws-secret", Pattern: regexp.MustCompile(`(?i)aws_?secret_?access_?key["']?\s*[:=]\s*["']?([A-Za-z0-9/+=]{40})`), Group: 1},
+	{Name: "bearer", Pattern: regexp.MustCompile(`(?i)\bbearer\s+([A-Za-z0-9\-._~+/]{8,}=*)`), Group: 1},
+	{Name: "private-key", Pattern: regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----[\s\S]*?-----END [A-Z ]*PRIVATE KEY-----`)},
+	{Name: "url-password", Pattern: regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^\s:/@]+:([^\s@/]+)@`), Group: 1},
+	{Name: "password", Pattern: regexp.MustCompile(`(?i)(?:password|passwd|pwd|senha|secret|token|api[_-]?key)["']?\s*[:=]\s*["']?([^\s"',;]{4,})`), Group: 1},
+	{Name: "email", Pattern: regexp.MustCompile(`\b[A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,}\b`)},
+	{Name: "ip", Pattern: regexp.MustCompile(`\b(?:(?:25[0-5]|2[0-4]\d|1?\d?\d)\.){3}(?:25[0-5]|2[0-4]\d|1?\d?\d)\b`)},
+}
+
+type Redactor struct {
+	rules []Rule
+}
+
+// CustomPattern é um detector definido pelo usuário na configuração. Se a
+// expressão tiver grupos, apenas o primeiro é mascarado.
+type CustomPattern struct {
+	Name    string
+	Pattern string
+}
+
+// New cria um Redactor com os detectores embutidos mais os padrões do usuário.
+func New(custom []CustomPattern) (*Redactor, error) {
+	rules := append([]Rule{}, builtinRules...)
+
+	for _, c := range custom {
+		re, err := regexp.Compile(c.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("padrão de redação %q inválido: %w", c.Name, err)
+		}
+		group := 0
+		if re.NumSubexp() > 0 {
+			group = 1
+		}
+		rules = append(rules, Rule{Name: c.Name, Pattern: re, Group: group})
+	}
+
+	return &Redactor{rules: rules}, nil
+}
+
+// Redact substitui os dados sensíveis em text por [REDACTED:<regra>] e
+// retorna o que foi mascarado.
+func (r *Redactor) Redact(text string) (string, []Finding) {
+	var findings []Finding
+
+	for _, rule := range r.rules {
+		var out strings.Builder
+		last := 0
+
+		for _, loc := range rule.Pattern.FindAllStringSubmatchIndex(text, -1) {
+			start, end := loc[0], loc[1]
+			if rule.Group > 0 && len(loc) > 2*rule.Group+1 && loc[2*rule.Group] >= 0 {
+				start, end = loc[2*rule.Group], loc[2*rule.Group+1]
+			}
+
+			value := text[start:end]
+			if strings.HasPrefix(value, "[REDACTED:") {
+				continue
+			}
+
+			out.WriteString(text[last:start])
+			out.WriteString("[REDACTED:" + rule.Name + "]")
+			last = end
+
+			findings = append(findings, Finding{Rule: rule.Name, Value: value})
+		}
+
+		if last > 0 {
+			out.WriteString(text[last:])
+			text = out.String()
+		}
+	}
+
+	return text, findings
+}
+
+var (
+	privateKeyBegin = regexp.MustCompile(`-----BEGIN [A-Z ]*PRIVATE KEY-----`)
+	privateKeyEnd   = regexp.MustCompile(`-----END [A-Z ]*PRIVATE KEY-----`)
+)
+
+// LineRedactor mascara um texto recebido linha a linha, como a resposta da IA
+// em streaming. Blocos de chave privada ocupam várias linhas e por isso são
+// omitidos por inteiro.
+type LineRedactor struct {
+	redactor *Redactor
+	inKey    bool
+}
+
+func (r *Redactor) Lines() *LineRedactor {
+	return &LineRedactor{redactor: r}
+}
+
+// Line retorna a linha mascarada e se ela deve ser exibida.
+func (l *LineRedactor) Line(line string) (string, bool) {
+	if l.inKey {
+		if privateKeyEnd.MatchString(line) {
+			l.inKey = false
+		}
+		return "", false
+	}
+
+	if privateKeyBegin.MatchString(line) && !privateKeyEnd.MatchString(line) {
+		l.inKey = true
+		return "[REDACTED:private-key]", true
+	}
+
+	redacted, _ := l.redactor.Redact(line)
+	return redacted, true
+}
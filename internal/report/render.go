@@ -0,0 +1,205 @@
+package report
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/yuin/goldmark"
+	"github.com/yuin/goldmark/extension"
+)
+
+// Formats são os formatos de saída suportados por Render.
+var Formats = []string{"md", "csv", "html"}
+
+// Render escreve o relatório em w no formato pedido, usando o layout padrão.
+func Render(w io.Writer, format string, r *Report) error {
+	switch format {
+	case "md", "markdown":
+		return executeText(w, "report.md", defaultMarkdown, r)
+	case "html":
+		return executeHTML(w, "report.html", defaultHTML, r)
+	case "csv":
+		return writeCSV(w, r)
+	default:
+		return fmt.Errorf("formato desconhecido: %s (use %s)", format, strings.Join(Formats, ", "))
+	}
+}
+
+// RenderTemplate usa um template Go do usuário. Arquivos .html/.htm usam
+// html/template (com escape automático); os demais, text/template.
+func RenderTemplate(w io.Writer, path string, r *Report) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	name := filepath.Base(path)
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".html", ".htm":
+		return executeHTML(w, name, string(data), r)
+	default:
+		return executeText(w, name, string(data), r)
+	}
+}
+
+var textFuncs = template.FuncMap{
+	"join":  strings.Join,
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"cell":  markdownCell,
+	"quote": quoteMarkdown,
+}
+
+func executeText(w io.Writer, name, text string, r *Report) error {
+	tmpl, err := template.New(name).Funcs(textFuncs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("erro no template %s: %w", name, err)
+	}
+	return tmpl.Execute(w, r)
+}
+
+func executeHTML(w io.Writer, name, text string, r *Report) error {
+	funcs := htmltemplate.FuncMap{
+		"join":     strings.Join,
+		"upper":    strings.ToUpper,
+		"lower":    strings.ToLower,
+		"markdown": markdownToHTML,
+	}
+
+	tmpl, err := htmltemplate.New(name).Funcs(funcs).Parse(text)
+	if err != nil {
+		return fmt.Errorf("erro no template %s: %w", name, err)
+	}
+	return tmpl.Execute(w, r)
+}
+
+var markdown = goldmark.New(goldmark.WithExtensions(extension.GFM))
+
+// markdownToHTML converte a descrição (markdown do OpenProject) em HTML. HTML
+// embutido na descrição é omitido pelo goldmark, então o resultado é seguro.
+func markdownToHTML(md string) htmltemplate.HTML {
+	var buf bytes.Buffer
+	if err := markdown.Convert([]byte(md), &buf); err != nil {
+		return htmltemplate.HTML(htmltemplate.HTMLEscapeString(md))
+	}
+	return htmltemplate.HTML(buf.String())
+}
+
+// markdownCell deixa um texto seguro para uma célula de tabela markdown.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", "\\|")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// quoteMarkdown prefixa cada linha com "> " para exibir a descrição como citação.
+func quoteMarkdown(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight("> "+line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
+
+func writeCSV(w io.Writer, r *Report) error {
+	cw := csv.NewWriter(w)
+
+	header := []string{"ID", "Título", "Status", "Tipo", "Prioridade", "Assignee", "Versão", "Início", "Prazo", "Estimado", "Gasto", "Relações", "Descrição", "URL"}
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+
+	for _, g := range r.Groups {
+		for _, item := range g.Items {
+			relations := make([]string, len(item.Relations))
+			for i, rel := range item.Relations {
+				relations[i] = rel.String()
+			}
+
+			record := []string{
+				fmt.Sprint(item.ID), item.Subject, item.Status, item.Type, item.Priority,
+				item.Assignee, item.Version, item.StartDate, item.DueDate,
+				item.Estimated, item.Spent, strings.Join(relations, "; "), item.Description, item.URL,
+			}
+			if err := cw.Write(record); err != nil {
+				return err
+			}
+		}
+	}
+
+	cw.Flush()
+	return cw.Error()
+}
+
+const defaultMarkdown = `# {{.Title}}
+
+**Projeto:** {{.Project}} · **Gerado em:** {{.GeneratedAt.Format "02/01/2006 15:04"}} · **Work packages:** {{.Total}} · **Estimado:** {{.Estimated}} · **Gasto:** {{.Spent}}
+{{range .Groups}}
+## {{.Name}} ({{len .Items}})
+
+| # | Título | Status | Tipo | Assignee | Prazo | Estimado | Gasto |
+|---|--------|--------|------|----------|-------|----------|-------|
+{{range .Items}}| {{if .URL}}[#{{.ID}}]({{.URL}}){{else}}#{{.ID}}{{end}} | {{cell .Subject}} | {{cell .Status}} | {{cell .Type}} | {{cell .Assignee}} | {{.DueDate}} | {{.Estimated}} | {{.Spent}} |
+{{end}}
+**Total do grupo:** estimado {{.Estimated}} · gasto {{.Spent}}
+{{range .Items}}{{if or .Description .Relations}}
+### #{{.ID}} {{.Subject}}
+{{if .Relations}}
+**Relações:** {{range $i, $r := .Relations}}{{if $i}}, {{end}}{{$r}}{{end}}
+{{end}}{{if .Description}}
+{{quote .Description}}
+{{end}}{{end}}{{end}}{{end}}`
+
+const defaultHTML = `<!DOCTYPE html>
+<html lang="pt-BR">
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+  body { font-family: -apple-system, "Segoe UI", Roboto, sans-serif; margin: 2rem auto; max-width: 1100px; color: #1f2937; padding: 0 1rem; }
+  h1 { color: #5b21b6; margin-bottom: .25rem; }
+  .meta { color: #6b7280; margin-bottom: 2rem; }
+  h2 { border-bottom: 2px solid #ede9fe; padding-bottom: .25rem; margin-top: 2.5rem; }
+  table { border-collapse: collapse; width: 100%; font-size: .9rem; }
+  th, td { text-align: left; padding: .4rem .6rem; border-bottom: 1px solid #e5e7eb; vertical-align: top; }
+  th { background: #f5f3ff; }
+  td.num { white-space: nowrap; }
+  .total { color: #6b7280; font-size: .9rem; margin: .5rem 0 1rem; }
+  details { margin: .5rem 0; padding: .5rem .75rem; border-left: 3px solid #ddd6fe; background: #fafafa; }
+  summary { cursor: pointer; font-weight: 600; }
+  .relations { color: #4b5563; font-size: .9rem; }
+  a { color: #6d28d9; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+<p class="meta">Projeto {{.Project}} · gerado em {{.GeneratedAt.Format "02/01/2006 15:04"}} · {{.Total}} work packages · estimado {{.Estimated}} · gasto {{.Spent}}</p>
+{{range .Groups}}
+<h2>{{.Name}} ({{len .Items}})</h2>
+<table>
+  <thead><tr><th>#</th><th>Título</th><th>Status</th><th>Tipo</th><th>Assignee</th><th>Prazo</th><th>Estimado</th><th>Gasto</th></tr></thead>
+  <tbody>
+  {{range .Items}}<tr>
+    <td class="num">{{if .URL}}<a href="{{.URL}}">#{{.ID}}</a>{{else}}#{{.ID}}{{end}}</td>
+    <td>{{.Subject}}</td><td>{{.Status}}</td><td>{{.Type}}</td><td>{{.Assignee}}</td>
+    <td class="num">{{.DueDate}}</td><td class="num">{{.Estimated}}</td><td class="num">{{.Spent}}</td>
+  </tr>
+  {{end}}</tbody>
+</table>
+<p class="total">Total do grupo: estimado {{.Estimated}} · gasto {{.Spent}}</p>
+{{range .Items}}{{if or .Description .Relations}}
+<details>
+  <summary>#{{.ID}} {{.Subject}}</summary>
+  {{if .Relations}}<p class="relations">Relações: {{range $i, $r := .Relations}}{{if $i}}, {{end}}{{$r.String}}{{end}}</p>{{end}}
+  {{if .Description}}{{markdown .Description}}{{end}}
+</details>
+{{end}}{{end}}{{end}}
+</body>
+</html>
+`
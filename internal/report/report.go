@@ -0,0 +1,226 @@
+package report
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/guialveess/opencli/internal/openproject"
+)
+
+// GroupFields são os campos aceitos para agrupar o relatório.
+var GroupFields = []string{"status", "type", "assignee", "version", "priority", "none"}
+
+// Report é o modelo passado aos templates (padrão ou do usuário).
+type Report struct {
+	Title       string
+	Project     string
+	GeneratedAt time.Time
+	GroupBy     string
+	Groups      []Group
+	Total       int
+	Estimated   string
+	Spent       string
+}
+
+type Group struct {
+	Name      string
+	Items     []Item
+	Estimated string
+	Spent     string
+}
+
+type Item struct {
+	ID          int
+	Subject     string
+	Status      string
+	Type        string
+	Priority    string
+	Assignee    string
+	Version     string
+	StartDate   string
+	DueDate     string
+	Estimated   string
+	Spent       string
+	Description string
+	URL         string
+	Relations   []RelationRef
+
+	// WorkPackage dá acesso aos demais campos (ex: .WorkPackage.CustomFields)
+	// em templates próprios.
+	WorkPackage openproject.WorkPackage
+}
+
+// RelationRef é uma relação vista a partir do item, ex: "bloqueia #12".
+type RelationRef struct {
+	Label   string
+	ID      int
+	Subject string
+}
+
+func (r RelationRef) String() string {
+	if r.Subject == "" {
+		return fmt.Sprintf("%s #%d", r.Label, r.ID)
+	}
+	return fmt.Sprintf("%s #%d (%s)", r.Label, r.ID, r.Subject)
+}
+
+type Options struct {
+	Title   string
+	Project string
+	GroupBy string
+	URL     func(id int) string
+}
+
+var relationLabels = map[string]string{
+	"relates":    "relacionado a",
+	"duplicates": "duplica",
+	"duplicated": "duplicado por",
+	"blocks":     "bloqueia",
+	"blocked":    "bloqueado por",
+	"precedes":   "precede",
+	"follows":    "segue",
+	"includes":   "inclui",
+	"partof":     "parte de",
+	"requires":   "requer",
+	"required":   "requerido por",
+}
+
+// Build agrupa os work packages e anexa a cada um as relações em que aparece.
+// Grupos ficam em ordem alfabética, com o grupo vazio (ex: sem assignee) por último.
+func Build(wps []openproject.WorkPackage, relations []openproject.Relation, opts Options) *Report {
+	r := &Report{
+		Title:       opts.Title,
+		Project:     opts.Project,
+		GeneratedAt: time.Now(),
+		GroupBy:     opts.GroupBy,
+		Total:       len(wps),
+	}
+
+	byWP := relationsByWorkPackage(relations)
+
+	groups := map[string]*Group{}
+	var names []string
+	var estimated, spent time.Duration
+	groupEstimated := map[string]time.Duration{}
+	groupSpent := map[string]time.Duration{}
+
+	sorted := make([]openproject.WorkPackage, len(wps))
+	copy(sorted, wps)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].ID < sorted[j].ID })
+
+	for _, wp := range sorted {
+		name := groupName(&wp, opts.GroupBy)
+		g, ok := groups[name]
+		if !ok {
+			g = &Group{Name: name}
+			groups[name] = g
+			names = append(names, name)
+		}
+
+		item := Item{
+			ID:          wp.ID,
+			Subject:     wp.Subject,
+			Status:      wp.Links.Status.Title,
+			Type:        wp.Links.Type.Title,
+			Priority:    wp.Links.Priority.Title,
+			Assignee:    wp.Links.Assignee.Title,
+			Version:     wp.Links.Version.Title,
+			StartDate:   formatDay(wp.StartDate),
+			DueDate:     formatDay(wp.DueDate),
+			Estimated:   openproject.FormatHours(wp.EstimatedTime),
+			Spent:       openproject.FormatHours(wp.SpentTime),
+			Description: strings.TrimSpace(wp.Description.Raw),
+			Relations:   byWP[wp.ID],
+			WorkPackage: wp,
+		}
+		if opts.URL != nil {
+			item.URL = opts.URL(wp.ID)
+		}
+		g.Items = append(g.Items, item)
+
+		e, _ := openproject.ParseDuration(wp.EstimatedTime)
+		s, _ := openproject.ParseDuration(wp.SpentTime)
+		estimated += e
+		spent += s
+		groupEstimated[name] += e
+		groupSpent[name] += s
+	}
+
+	sort.Slice(names, func(i, j int) bool {
+		if names[i] == "" || names[j] == "" {
+			return names[j] == ""
+		}
+		return names[i] < names[j]
+	})
+
+	for _, name := range names {
+		g := groups[name]
+		if g.Name == "" {
+			g.Name = emptyGroupName(opts.GroupBy)
+		}
+		g.Estimated = openproject.Hours(groupEstimated[name])
+		g.Spent = openproject.Hours(groupSpent[name])
+		r.Groups = append(r.Groups, *g)
+	}
+
+	r.Estimated = openproject.Hours(estimated)
+	r.Spent = openproject.Hours(spent)
+	return r
+}
+
+func relationsByWorkPackage(relations []openproject.Relation) map[int][]RelationRef {
+	byWP := map[int][]RelationRef{}
+
+	for _, rel := range relations {
+		from, to := rel.Links.From, rel.Links.To
+		byWP[from.ID()] = append(byWP[from.ID()], RelationRef{Label: relationLabel(rel.Type), ID: to.ID(), Subject: to.Title})
+		byWP[to.ID()] = append(byWP[to.ID()], RelationRef{Label: relationLabel(rel.ReverseType), ID: from.ID(), Subject: from.Title})
+	}
+
+	return byWP
+}
+
+func relationLabel(t string) string {
+	if label, ok := relationLabels[t]; ok {
+		return label
+	}
+	return t
+}
+
+func groupName(wp *openproject.WorkPackage, groupBy string) string {
+	switch groupBy {
+	case "status":
+		return wp.Links.Status.Title
+	case "type":
+		return wp.Links.Type.Title
+	case "assignee":
+		return wp.Links.Assignee.Title
+	case "version":
+		return wp.Links.Version.Title
+	case "priority":
+		return wp.Links.Priority.Title
+	}
+	return ""
+}
+
+func emptyGroupName(groupBy string) string {
+	switch groupBy {
+	case "none", "":
+		return "Work Packages"
+	case "assignee":
+		return "Sem assignee"
+	case "version":
+		return "Sem versão"
+	}
+	return "Sem " + groupBy
+}
+
+func formatDay(day string) string {
+	t, err := time.Parse("2006-01-02", day)
+	if err != nil {
+		return day
+	}
+	return t.Format("02/01/2006")
+}
@@ -0,0 +1,52 @@
+package ui
+
+import (
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/glamour"
+	"golang.org/x/term"
+)
+
+// TerminalWidth retorna a largura do terminal, ou fallback se não for um terminal.
+func TerminalWidth(fallback int) int {
+	width, _, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || width <= 0 {
+		return fallback
+	}
+	return width
+}
+
+var urlPattern = regexp.MustCompile(`https?://[^\s\x1b]+`)
+
+// RenderMarkdown converte markdown em texto estilizado para o terminal, com
+// parágrafos quebrados em width colunas e blocos de código destacados. Em um
+// terminal, URLs viram links clicáveis (OSC 8).
+func RenderMarkdown(markdown string, width int) (string, error) {
+	renderer, err := glamour.NewTermRenderer(
+		glamour.WithAutoStyle(),
+		glamour.WithWordWrap(width),
+		glamour.WithEmoji(),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	out, err := renderer.Render(markdown)
+	if err != nil {
+		return "", err
+	}
+
+	out = strings.Trim(out, "\n")
+
+	if term.IsTerminal(int(os.Stdout.Fd())) {
+		out = urlPattern.ReplaceAllStringFunc(out, hyperlink)
+	}
+
+	return out, nil
+}
+
+func hyperlink(url string) string {
+	return "\x1b]8;;" + url + "\x1b\\" + url + "\x1b]8;;\x1b\\"
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/briandowns/spinner"
@@ -20,6 +21,14 @@ func StartSpinner(msg string) {
 	s.Start()
 }
 
+// UpdateSpinner troca a mensagem do spinner em execução; é seguro chamar de
+// várias goroutines.
+func UpdateSpinner(msg string) {
+	s.Lock()
+	s.Suffix = " " + msg
+	s.Unlock()
+}
+
 func StopSpinner() {
 	s.Stop()
 }
@@ -81,3 +90,73 @@ func PrintError(msg string) {
 func PrintInfo(msg string) {
 	fmt.Println(MutedStyle.Render(msg))
 }
+
+// DraftPrinter exibe o texto gerado pela IA conforme os tokens chegam,
+// substituindo o spinner no primeiro token. Com Filter, o texto é exibido
+// linha a linha, só depois de passar pelo filtro (ex: mascaramento de segredos).
+type DraftPrinter struct {
+	Filter func(line string) (string, bool)
+
+	started bool
+	pending strings.Builder
+}
+
+func (d *DraftPrinter) Write(token string) {
+	if !d.started {
+		StopSpinner()
+		fmt.Println(LabelStyle.Render("Rascunho da IA:"))
+		d.started = true
+	}
+
+	if d.Filter != nil {
+		d.pending.WriteString(token)
+		text := d.pending.String()
+		for {
+			i := strings.IndexByte(text, '\n')
+			if i < 0 {
+				break
+			}
+			d.printLine(text[:i])
+			text = text[i+1:]
+		}
+		d.pending.Reset()
+		d.pending.WriteString(text)
+		return
+	}
+
+	parts := strings.Split(token, "\n")
+	for i, part := range parts {
+		if i > 0 {
+			fmt.Println()
+		}
+		if part != "" {
+			fmt.Print(MutedStyle.Render(part))
+		}
+	}
+}
+
+func (d *DraftPrinter) printLine(line string) {
+	line, ok := d.Filter(line)
+	if !ok {
+		return
+	}
+	fmt.Println(MutedStyle.Render(line))
+}
+
+func (d *DraftPrinter) Done() {
+	if !d.started {
+		return
+	}
+
+	if d.Filter != nil {
+		if d.pending.Len() > 0 {
+			d.printLine(d.pending.String())
+			d.pending.Reset()
+		}
+		fmt.Println()
+		return
+	}
+
+	fmt.Println()
+	fmt.Println()
+}
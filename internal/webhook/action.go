@@ -0,0 +1,328 @@
+package webhook
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/guialveess/opencli/internal/config"
+	"github.com/guialveess/opencli/internal/notify"
+	"github.com/guialveess/opencli/internal/openproject"
+)
+
+// runTimeout limita quanto tempo um comando de ação pode rodar.
+const runTimeout = 5 * time.Minute
+
+// Fields são os campos do work package aceitos em when e changed.
+var Fields = []string{"status", "type", "priority", "assignee", "responsible", "author", "version", "category", "project", "subject"}
+
+// Data são as variáveis dos templates de notify e message. Para comandos
+// (run) as mesmas informações vão em variáveis de ambiente OP_*, e o payload
+// completo na entrada padrão.
+type Data struct {
+	Action      string
+	ID          int
+	Subject     string
+	Status      string
+	Type        string
+	Priority    string
+	Assignee    string
+	Responsible string
+	Version     string
+	Project     string
+	URL         string
+	WorkPackage *openproject.WorkPackage
+}
+
+type action struct {
+	config.WebhookAction
+	notify  *template.Template
+	message *template.Template
+}
+
+// Dispatcher executa as ações configuradas para cada evento, um evento por
+// vez e na ordem de chegada, fora da goroutine que responde ao OpenProject.
+type Dispatcher struct {
+	actions []action
+	url     func(int) string
+	out     io.Writer
+	logf    func(format string, args ...any)
+	queue   chan *Event
+
+	// último valor visto de cada campo por work package, para "changed"
+	last map[int]map[string]string
+
+	desktop bool
+}
+
+// NewDispatcher valida as ações e compila os templates. url monta o link do
+// work package na interface web; out recebe as mensagens das ações.
+func NewDispatcher(actions []config.WebhookAction, url func(int) string, out io.Writer, logf func(format string, args ...any)) (*Dispatcher, error) {
+	d := &Dispatcher{
+		url:     url,
+		out:     out,
+		logf:    logf,
+		queue:   make(chan *Event, 100),
+		last:    map[int]map[string]string{},
+		desktop: true,
+	}
+
+	for i, a := range actions {
+		if a.Name == "" {
+			a.Name = fmt.Sprintf("ação %d", i+1)
+		}
+		if a.Run == "" && a.Notify == "" && a.Message == "" {
+			return nil, fmt.Errorf("%s: defina run, notify ou message", a.Name)
+		}
+		for _, pattern := range a.Events {
+			if _, err := path.Match(pattern, ""); err != nil {
+				return nil, fmt.Errorf("%s: evento inválido %q", a.Name, pattern)
+			}
+		}
+		for field := range a.When {
+			if !slices.Contains(Fields, strings.ToLower(field)) {
+				return nil, fmt.Errorf("%s: campo desconhecido em when: %s (use %s)", a.Name, field, strings.Join(Fields, ", "))
+			}
+		}
+		for _, field := range a.Changed {
+			if !slices.Contains(Fields, strings.ToLower(field)) {
+				return nil, fmt.Errorf("%s: campo desconhecido em changed: %s (use %s)", a.Name, field, strings.Join(Fields, ", "))
+			}
+		}
+
+		compiled := action{WebhookAction: a}
+		var err error
+		if compiled.notify, err = parseTemplate(a.Name+" (notify)", a.Notify); err != nil {
+			return nil, err
+		}
+		if compiled.message, err = parseTemplate(a.Name+" (message)", a.Message); err != nil {
+			return nil, err
+		}
+		d.actions = append(d.actions, compiled)
+	}
+
+	return d, nil
+}
+
+func parseTemplate(name, text string) (*template.Template, error) {
+	if text == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New(name).Option("missingkey=zero").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("template inválido em %s: %w", name, err)
+	}
+	return tmpl, nil
+}
+
+// Enqueue agenda o evento sem bloquear. Se a fila estiver cheia (ações lentas
+// e uma rajada de eventos), o evento é descartado com um aviso.
+func (d *Dispatcher) Enqueue(event *Event) {
+	select {
+	case d.queue <- event:
+	default:
+		d.logf("fila cheia, evento %s descartado", event.Action)
+	}
+}
+
+// Run processa a fila até ctx ser cancelado.
+func (d *Dispatcher) Run(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event := <-d.queue:
+			d.Dispatch(ctx, event)
+		}
+	}
+}
+
+// Dispatch executa, em ordem, as ações que casam com o evento.
+func (d *Dispatcher) Dispatch(ctx context.Context, event *Event) {
+	data := d.data(event)
+	current := fieldValues(event.WorkPackage)
+
+	matched := 0
+	for _, a := range d.actions {
+		if !d.matches(a, event, current) {
+			continue
+		}
+		matched++
+		d.execute(ctx, a, event, data)
+	}
+
+	if event.WorkPackage != nil {
+		d.last[event.WorkPackage.ID] = current
+	}
+	if matched == 0 {
+		d.logf("%s %s: nenhuma ação", event.Action, describe(event))
+	}
+}
+
+func (d *Dispatcher) matches(a action, event *Event, current map[string]string) bool {
+	if len(a.Events) > 0 && !slices.ContainsFunc(a.Events, func(pattern string) bool {
+		ok, _ := path.Match(pattern, event.Action)
+		return ok
+	}) {
+		return false
+	}
+
+	if len(a.When) > 0 || len(a.Changed) > 0 {
+		if event.WorkPackage == nil {
+			return false
+		}
+	}
+
+	for field, want := range a.When {
+		if !strings.EqualFold(current[strings.ToLower(field)], want) {
+			return false
+		}
+	}
+
+	if len(a.Changed) > 0 {
+		previous, seen := d.last[event.WorkPackage.ID]
+		// sem evento anterior do work package, conta como mudança
+		if seen && !slices.ContainsFunc(a.Changed, func(field string) bool {
+			field = strings.ToLower(field)
+			return previous[field] != current[field]
+		}) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (d *Dispatcher) execute(ctx context.Context, a action, event *Event, data Data) {
+	d.logf("%s %s: %s", event.Action, describe(event), a.Name)
+
+	if a.message != nil {
+		if text, err := render(a.message, data); err != nil {
+			d.logf("%s: %v", a.Name, err)
+		} else {
+			fmt.Fprintln(d.out, text)
+		}
+	}
+
+	if a.notify != nil && d.desktop {
+		text, err := render(a.notify, data)
+		if err == nil {
+			err = notify.Send("OpenProject", text)
+		}
+		if err != nil {
+			d.logf("%s: %v", a.Name, err)
+			if errors.Is(err, notify.ErrUnavailable) {
+				d.desktop = false
+			}
+		}
+	}
+
+	if a.Run != "" {
+		d.run(ctx, a, event, data)
+	}
+}
+
+// run executa o comando com sh -c. Os dados do evento vão no ambiente, e não
+// interpolados no comando, para que um título malicioso não vire shell.
+func (d *Dispatcher) run(ctx context.Context, a action, event *Event, data Data) {
+	ctx, cancel := context.WithTimeout(ctx, runTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", a.Run)
+	cmd.Stdin = bytes.NewReader(event.Payload)
+	cmd.Env = append(os.Environ(),
+		"OP_ACTION="+data.Action,
+		"OP_WP_ID="+idString(data.ID),
+		"OP_WP_SUBJECT="+data.Subject,
+		"OP_WP_STATUS="+data.Status,
+		"OP_WP_TYPE="+data.Type,
+		"OP_WP_PRIORITY="+data.Priority,
+		"OP_WP_ASSIGNEE="+data.Assignee,
+		"OP_WP_VERSION="+data.Version,
+		"OP_WP_PROJECT="+data.Project,
+		"OP_WP_URL="+data.URL,
+	)
+
+	output, err := cmd.CombinedOutput()
+	scanner := bufio.NewScanner(bytes.NewReader(output))
+	for scanner.Scan() {
+		d.logf("[%s] %s", a.Name, scanner.Text())
+	}
+	if err != nil {
+		d.logf("%s: comando falhou: %v", a.Name, err)
+	}
+}
+
+func (d *Dispatcher) data(event *Event) Data {
+	data := Data{Action: event.Action}
+	wp := event.WorkPackage
+	if wp == nil {
+		return data
+	}
+
+	data.ID = wp.ID
+	data.Subject = wp.Subject
+	data.Status = wp.Links.Status.Title
+	data.Type = wp.Links.Type.Title
+	data.Priority = wp.Links.Priority.Title
+	data.Assignee = wp.Links.Assignee.Title
+	data.Responsible = wp.Links.Responsible.Title
+	data.Version = wp.Links.Version.Title
+	data.Project = wp.Links.Project.Title
+	data.WorkPackage = wp
+	if d.url != nil {
+		data.URL = d.url(wp.ID)
+	}
+	return data
+}
+
+func fieldValues(wp *openproject.WorkPackage) map[string]string {
+	if wp == nil {
+		return nil
+	}
+	return map[string]string{
+		"status":      wp.Links.Status.Title,
+		"type":        wp.Links.Type.Title,
+		"priority":    wp.Links.Priority.Title,
+		"assignee":    wp.Links.Assignee.Title,
+		"responsible": wp.Links.Responsible.Title,
+		"author":      wp.Links.Author.Title,
+		"version":     wp.Links.Version.Title,
+		"category":    wp.Links.Category.Title,
+		"project":     wp.Links.Project.Title,
+		"subject":     wp.Subject,
+	}
+}
+
+func render(tmpl *template.Template, data Data) (string, error) {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+func describe(event *Event) string {
+	if event.WorkPackage == nil {
+		return ""
+	}
+	return fmt.Sprintf("#%d", event.WorkPackage.ID)
+}
+
+func idString(id int) string {
+	if id == 0 {
+		return ""
+	}
+	return strconv.Itoa(id)
+}
@@ -0,0 +1,160 @@
+package webhook
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/guialveess/opencli/internal/openproject"
+)
+
+// SignatureHeader é o cabeçalho em que o OpenProject envia o HMAC-SHA1 do corpo,
+// no formato "sha1=<hex>".
+const SignatureHeader = "X-OP-Signature"
+
+// maxPayloadSize limita o corpo aceito; payloads de work package têm poucos KB.
+const maxPayloadSize = 5 << 20
+
+// ErrInvalidSignature indica que a assinatura está ausente ou não confere com o
+// secret configurado.
+var ErrInvalidSignature = errors.New("assinatura inválida")
+
+// Event é um evento recebido do OpenProject. WorkPackage é nil quando o evento
+// não é de work package (ex: project:created, time_entry:created).
+type Event struct {
+	Action      string
+	WorkPackage *openproject.WorkPackage
+	Payload     []byte
+}
+
+// Sign calcula a assinatura do corpo como o OpenProject envia em SignatureHeader.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha1.New, []byte(secret))
+	mac.Write(body)
+	return "sha1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify confere a assinatura do corpo em tempo constante.
+func Verify(secret string, body []byte, signature string) bool {
+	if signature == "" {
+		return false
+	}
+	return hmac.Equal([]byte(Sign(secret, body)), []byte(strings.TrimSpace(signature)))
+}
+
+// Decode interpreta o corpo de um webhook do OpenProject:
+// {"action": "work_package:updated", "work_package": {...}}.
+func Decode(body []byte) (*Event, error) {
+	var payload struct {
+		Action      string          `json:"action"`
+		WorkPackage json.RawMessage `json:"work_package"`
+	}
+	if err := json.Unmarshal(body, &payload); err != nil {
+		return nil, fmt.Errorf("payload inválido: %w", err)
+	}
+	if payload.Action == "" {
+		return nil, errors.New("payload sem action")
+	}
+
+	event := &Event{Action: payload.Action, Payload: body}
+	if len(payload.WorkPackage) > 0 && string(payload.WorkPackage) != "null" {
+		var wp openproject.WorkPackage
+		if err := json.Unmarshal(payload.WorkPackage, &wp); err != nil {
+			return nil, fmt.Errorf("work_package inválido: %w", err)
+		}
+		event.WorkPackage = &wp
+	}
+
+	return event, nil
+}
+
+// Handler recebe os webhooks por POST, verifica a assinatura (se secret não
+// for vazio), grava o corpo em recordDir (se não for vazio) e entrega o evento
+// a handle. Responde assim que o evento é aceito; handle não deve bloquear.
+func Handler(secret, recordDir string, handle func(*Event), logf func(format string, args ...any)) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "use POST", http.StatusMethodNotAllowed)
+			return
+		}
+
+		body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxPayloadSize))
+		if err != nil {
+			http.Error(w, "corpo muito grande", http.StatusRequestEntityTooLarge)
+			return
+		}
+
+		if secret != "" && !Verify(secret, body, r.Header.Get(SignatureHeader)) {
+			logf("requisição de %s recusada: %v", r.RemoteAddr, ErrInvalidSignature)
+			http.Error(w, ErrInvalidSignature.Error(), http.StatusUnauthorized)
+			return
+		}
+
+		event, err := Decode(body)
+		if err != nil {
+			logf("requisição de %s recusada: %v", r.RemoteAddr, err)
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if recordDir != "" {
+			if path, err := record(recordDir, event); err != nil {
+				logf("falha ao gravar payload: %v", err)
+			} else {
+				logf("payload gravado em %s", path)
+			}
+		}
+
+		handle(event)
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+var unsafeFileChars = regexp.MustCompile(`[^a-zA-Z0-9_-]+`)
+
+// record salva o corpo do evento em dir, em um arquivo por evento ordenável
+// pela hora de chegada, para ser reenviado com Replay.
+func record(dir string, event *Event) (string, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return "", err
+	}
+
+	name := time.Now().Format("20060102-150405.000000") + "-" + unsafeFileChars.ReplaceAllString(event.Action, "_") + ".json"
+	path := filepath.Join(dir, name)
+	return path, os.WriteFile(path, event.Payload, 0o600)
+}
+
+// Replay reenvia um payload gravado para url, assinado com secret como o
+// OpenProject faria. Retorna o status HTTP da resposta.
+func Replay(url, secret string, body []byte) (int, error) {
+	req, err := http.NewRequest(http.MethodPost, url, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if secret != "" {
+		req.Header.Set(SignatureHeader, Sign(secret, body))
+	}
+
+	client := &http.Client{Timeout: 15 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, resp.Body)
+
+	return resp.StatusCode, nil
+}